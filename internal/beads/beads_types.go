@@ -65,8 +65,8 @@ func ResolveRoutingTarget(townRoot, beadID, fallbackDir string) string {
 		return fallbackDir
 	}
 
-	// Look up rig path for this prefix
-	rigPath := GetRigPathForPrefix(townRoot, prefix)
+	// Look up rig path by the full ID so overlapping prefixes honor priority
+	rigPath := GetRigPathForID(townRoot, beadID)
 	if rigPath == "" {
 		fmt.Fprintf(os.Stderr, "Warning: no route found for prefix %q (bead %s), falling back to %s\n", prefix, beadID, fallbackDir)
 		return fallbackDir
@@ -0,0 +1,100 @@
+package beads
+
+import (
+	"sync"
+)
+
+// RouteCache holds a town's routes.jsonl in memory so repeated prefix
+// lookups don't re-read the file. It is safe for concurrent use.
+// The cache does not notice file changes on its own; call Reload, e.g. from
+// a WatchRoutes callback.
+type RouteCache struct {
+	mu       sync.RWMutex
+	townRoot string
+	routes   []Route // sorted by priority, as from LoadRoutes
+}
+
+// NewRouteCacheFromDir returns a RouteCache loaded from townRoot's routes.
+func NewRouteCacheFromDir(townRoot string) (*RouteCache, error) {
+	c := &RouteCache{}
+	if err := c.Load(townRoot); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Load reads townRoot's routes.jsonl, replacing any cached routes.
+// A missing routes file leaves the cache empty.
+func (c *RouteCache) Load(townRoot string) error {
+	routes, err := LoadRoutes(GetTownBeadsPath(townRoot))
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.townRoot = townRoot
+	c.routes = routes
+	return nil
+}
+
+// Reload re-reads routes for the town the cache was last loaded from.
+func (c *RouteCache) Reload() error {
+	c.mu.RLock()
+	townRoot := c.townRoot
+	c.mu.RUnlock()
+	return c.Load(townRoot)
+}
+
+// TownRoot returns the town the cache was last loaded from.
+func (c *RouteCache) TownRoot() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.townRoot
+}
+
+// GetRigPath is the cached equivalent of GetRigPathForPrefix.
+func (c *RouteCache) GetRigPath(prefix string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	r, ok := findRoute(c.routes, prefix)
+	if !ok {
+		return "", false
+	}
+	return routeRigPath(c.townRoot, r), true
+}
+
+// GetRigPathForID is the cached equivalent of GetRigPathForID.
+func (c *RouteCache) GetRigPathForID(beadID string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	r, ok := matchRoute(c.routes, beadID)
+	if !ok {
+		return "", false
+	}
+	return routeRigPath(c.townRoot, r), true
+}
+
+// GetRigName is the cached equivalent of GetRigNameForPrefix. Town-level
+// routes have no rig and report "", false.
+func (c *RouteCache) GetRigName(prefix string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	r, ok := findRoute(c.routes, prefix)
+	if !ok || r.Path == "." {
+		return "", false
+	}
+	return routeRigName(r), true
+}
+
+// GetRigNameForID is the cached equivalent of GetRigNameForID. Town-level
+// routes have no rig and report "", false.
+func (c *RouteCache) GetRigNameForID(beadID string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	r, ok := matchRoute(c.routes, beadID)
+	if !ok || r.Path == "." {
+		return "", false
+	}
+	return routeRigName(r), true
+}
@@ -0,0 +1,130 @@
+package beads
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTestRoutes(t testing.TB, townRoot, content string) {
+	t.Helper()
+	beadsDir := filepath.Join(townRoot, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(beadsDir, RoutesFileName), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRouteCache_MatchesGetRigPathForPrefix(t *testing.T) {
+	townRoot := t.TempDir()
+	writeTestRoutes(t, townRoot, `{"prefix": "ap-", "path": "ai_platform/mayor/rig"}
+{"prefix": "gt-", "path": "gastown/mayor/rig"}
+{"prefix": "gt-", "path": "shadow/mayor/rig"}
+{"prefix": "hq-", "path": "."}
+`)
+
+	c, err := NewRouteCacheFromDir(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, prefix := range []string{"ap-", "gt-", "hq-", "unknown-", ""} {
+		want := GetRigPathForPrefix(townRoot, prefix)
+		got, ok := c.GetRigPath(prefix)
+		if got != want || ok != (want != "") {
+			t.Errorf("GetRigPath(%q) = %q, %v; want %q", prefix, got, ok, want)
+		}
+	}
+	for _, id := range []string{"ap-abc", "gt-abc", "hq-cv-abc", "unknown-abc", ""} {
+		want := GetRigPathForID(townRoot, id)
+		got, ok := c.GetRigPathForID(id)
+		if got != want || ok != (want != "") {
+			t.Errorf("GetRigPathForID(%q) = %q, %v; want %q", id, got, ok, want)
+		}
+	}
+}
+
+func TestRouteCache_Reload(t *testing.T) {
+	townRoot := t.TempDir()
+	c, err := NewRouteCacheFromDir(townRoot)
+	if err != nil {
+		t.Fatalf("missing routes file should not be an error: %v", err)
+	}
+	if _, ok := c.GetRigPath("gt-"); ok {
+		t.Fatal("expected empty cache without routes.jsonl")
+	}
+
+	writeTestRoutes(t, townRoot, `{"prefix": "gt-", "path": "gastown/mayor/rig"}`)
+	if _, ok := c.GetRigPath("gt-"); ok {
+		t.Fatal("cache should not see file changes before Reload")
+	}
+	if err := c.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := c.GetRigPath("gt-"); !ok || got != filepath.Join(townRoot, "gastown/mayor/rig") {
+		t.Errorf("after Reload GetRigPath(gt-) = %q, %v", got, ok)
+	}
+}
+
+func benchmarkRoutes(b *testing.B) string {
+	townRoot := b.TempDir()
+	var content string
+	for i := 0; i < 50; i++ {
+		content += fmt.Sprintf("{\"prefix\": \"r%d-\", \"path\": \"rig%d/mayor/rig\"}\n", i, i)
+	}
+	writeTestRoutes(b, townRoot, content)
+	return townRoot
+}
+
+// BenchmarkGetRigPathForPrefix compares 1000 uncached lookups, each reading
+// routes.jsonl, with 1000 lookups against a RouteCache.
+func BenchmarkGetRigPathForPrefix(b *testing.B) {
+	townRoot := benchmarkRoutes(b)
+
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < 1000; j++ {
+				_ = GetRigPathForPrefix(townRoot, fmt.Sprintf("r%d-", j%50))
+			}
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		c, err := NewRouteCacheFromDir(townRoot)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < 1000; j++ {
+				_, _ = c.GetRigPath(fmt.Sprintf("r%d-", j%50))
+			}
+		}
+	})
+}
+
+func TestRouteCache_GetRigName(t *testing.T) {
+	townRoot := t.TempDir()
+	writeTestRoutes(t, townRoot, `{"prefix": "gt-", "path": "gastown/mayor/rig"}
+{"prefix": "hq-", "path": "."}
+`)
+	c, err := NewRouteCacheFromDir(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, prefix := range []string{"gt-", "hq-", "zz-"} {
+		want := GetRigNameForPrefix(townRoot, prefix)
+		got, ok := c.GetRigName(prefix)
+		if got != want || ok != (want != "") {
+			t.Errorf("GetRigName(%q) = %q, %v; want %q", prefix, got, ok, want)
+		}
+	}
+	for _, id := range []string{"gt-abc", "hq-abc", "zz-abc"} {
+		want := GetRigNameForID(townRoot, id)
+		got, ok := c.GetRigNameForID(id)
+		if got != want || ok != (want != "") {
+			t.Errorf("GetRigNameForID(%q) = %q, %v; want %q", id, got, ok, want)
+		}
+	}
+}
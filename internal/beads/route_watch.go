@@ -0,0 +1,87 @@
+package beads
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// ErrRoutesWatchLost is passed to WatchRoutes' onStop when the watched .beads
+// directory is removed or replaced.
+var ErrRoutesWatchLost = errors.New(".beads directory was removed or replaced; routes watch stopped")
+
+// WatchRoutes calls onChange whenever townRoot's routes.jsonl is created,
+// written, replaced or removed. It watches the .beads directory rather than
+// the file itself because WriteRoutes replaces the file via rename.
+// fsnotify uses inotify on Linux and kqueue on macOS/BSD.
+//
+// It returns an error if file watching is unavailable or .beads doesn't
+// exist; callers should then fall back to reloading on demand. The returned
+// cancel stops the watcher and is safe to call more than once.
+//
+// If .beads itself is removed or renamed (e.g. recreated by a reinstall), the
+// watch can no longer see routes.jsonl: onChange is called one last time,
+// then onStop (if non-nil) with ErrRoutesWatchLost, and watching ends.
+// onStop runs on the watcher goroutine and must not call cancel.
+func WatchRoutes(townRoot string, onChange func(), onStop func(error)) (cancel func(), err error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	beadsDir := GetTownBeadsPath(townRoot)
+	if err := watcher.Add(beadsDir); err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) == filepath.Clean(beadsDir) {
+					if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+						onChange()
+						if onStop != nil {
+							onStop(ErrRoutesWatchLost)
+						}
+						return
+					}
+					continue
+				}
+				if filepath.Base(event.Name) != RoutesFileName {
+					continue
+				}
+				if event.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Rename|fsnotify.Remove) != 0 {
+					onChange()
+				}
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				// Errors are usually queue overflows; a spurious reload is
+				// harmless, so treat them as a change.
+				onChange()
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			_ = watcher.Close()
+			wg.Wait()
+		})
+	}, nil
+}
@@ -0,0 +1,67 @@
+package beads
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatchRoutes_FiresOnChange(t *testing.T) {
+	townRoot := t.TempDir()
+	writeTestRoutes(t, townRoot, `{"prefix": "gt-", "path": "gastown/mayor/rig"}`+"\n")
+
+	changed := make(chan struct{}, 16)
+	cancel, err := WatchRoutes(townRoot, func() { changed <- struct{}{} }, nil)
+	if err != nil {
+		t.Skipf("file watching unavailable: %v", err)
+	}
+	defer cancel()
+
+	// WriteRoutes replaces the file via rename, like real route edits.
+	routes := []Route{{Prefix: "gt-", Path: "gastown/mayor/rig"}, {Prefix: "bd-", Path: "beads/mayor/rig"}}
+	if err := WriteRoutes(GetTownBeadsPath(townRoot), routes); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-changed:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("onChange not called within 500ms of modifying routes.jsonl")
+	}
+
+	cancel()
+	cancel() // safe to call twice
+}
+
+func TestWatchRoutes_MissingBeadsDir(t *testing.T) {
+	if _, err := WatchRoutes(filepath.Join(t.TempDir(), "no-town"), func() {}, nil); err == nil {
+		t.Error("expected an error when .beads does not exist")
+	}
+}
+
+func TestWatchRoutes_StopsWhenBeadsDirRemoved(t *testing.T) {
+	townRoot := t.TempDir()
+	writeTestRoutes(t, townRoot, `{"prefix": "gt-", "path": "gastown/mayor/rig"}`+"\n")
+
+	stopped := make(chan error, 1)
+	cancel, err := WatchRoutes(townRoot, func() {}, func(err error) { stopped <- err })
+	if err != nil {
+		t.Skipf("file watching unavailable: %v", err)
+	}
+	defer cancel()
+
+	if err := os.RemoveAll(GetTownBeadsPath(townRoot)); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-stopped:
+		if !errors.Is(err, ErrRoutesWatchLost) {
+			t.Errorf("onStop error = %v, want ErrRoutesWatchLost", err)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("onStop not called within 500ms of removing .beads")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/config"
@@ -15,14 +16,17 @@ import (
 // Route represents a prefix-to-path routing rule.
 // This mirrors the structure in bd's internal/routing package.
 type Route struct {
-	Prefix string `json:"prefix"` // Issue ID prefix (e.g., "gt-")
-	Path   string `json:"path"`   // Relative path to .beads directory from town root
+	Prefix   string `json:"prefix"`             // Issue ID prefix (e.g., "gt-")
+	Path     string `json:"path"`               // Relative path to .beads directory from town root
+	Priority int    `json:"priority,omitempty"` // Lower wins when prefixes overlap (default 0)
 }
 
 // RoutesFileName is the name of the routes configuration file.
 const RoutesFileName = "routes.jsonl"
 
-// LoadRoutes loads routes from routes.jsonl in the given beads directory.
+// LoadRoutes loads routes from routes.jsonl in the given beads directory,
+// sorted by Priority. The sort is stable, so routes with equal priority keep
+// their file order and the first match still wins among them.
 // Returns an empty slice if the file doesn't exist.
 func LoadRoutes(beadsDir string) ([]Route, error) {
 	routesPath := filepath.Join(beadsDir, RoutesFileName)
@@ -55,9 +59,73 @@ func LoadRoutes(beadsDir string) ([]Route, error) {
 		}
 	}
 
+	sortRoutes(routes)
 	return routes, scanner.Err()
 }
 
+// sortRoutes orders routes by Priority, keeping file order for ties.
+func sortRoutes(routes []Route) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].Priority < routes[j].Priority
+	})
+}
+
+// findRoute returns the first route in routes whose prefix equals prefix.
+// With routes sorted by sortRoutes, duplicates resolve to the lowest Priority.
+func findRoute(routes []Route, prefix string) (Route, bool) {
+	for _, r := range routes {
+		if r.Prefix == prefix {
+			return r, true
+		}
+	}
+	return Route{}, false
+}
+
+// matchRoute returns the first route in routes whose prefix starts id, a
+// full bead ID such as "gt-crew-bear". With routes sorted by sortRoutes the
+// lowest Priority wins and equal priorities fall back to file order, which
+// ValidateRoutes reports as ambiguous.
+func matchRoute(routes []Route, id string) (Route, bool) {
+	for _, r := range routes {
+		if strings.HasPrefix(id, r.Prefix) {
+			return r, true
+		}
+	}
+	return Route{}, false
+}
+
+// ValidateRoutes reports route pairs that point at different paths and
+// whose resolution is ambiguous or surprising:
+//   - one prefix starts the other (or they are equal) and they share a
+//     priority, so file order alone decides which one an ID matches;
+//   - a more specific prefix can never match because a shorter prefix has a
+//     lower Priority.
+//
+// Give the more specific prefix the lower Priority to resolve either case.
+func ValidateRoutes(routes []Route) []error {
+	var errs []error
+	for i := range routes {
+		for j := i + 1; j < len(routes); j++ {
+			a, b := routes[i], routes[j]
+			if a.Path == b.Path {
+				continue
+			}
+			switch {
+			case a.Priority == b.Priority && (strings.HasPrefix(a.Prefix, b.Prefix) || strings.HasPrefix(b.Prefix, a.Prefix)):
+				errs = append(errs, fmt.Errorf("routes %q (%s) and %q (%s) overlap at priority %d; give the more specific prefix a lower priority",
+					a.Prefix, a.Path, b.Prefix, b.Path, a.Priority))
+			case a.Priority < b.Priority && strings.HasPrefix(b.Prefix, a.Prefix):
+				errs = append(errs, fmt.Errorf("route %q (%s) is unreachable: %q (%s) has a lower priority (%d < %d)",
+					b.Prefix, b.Path, a.Prefix, a.Path, a.Priority, b.Priority))
+			case b.Priority < a.Priority && strings.HasPrefix(a.Prefix, b.Prefix):
+				errs = append(errs, fmt.Errorf("route %q (%s) is unreachable: %q (%s) has a lower priority (%d < %d)",
+					a.Prefix, a.Path, b.Prefix, b.Path, b.Priority, a.Priority))
+			}
+		}
+	}
+	return errs
+}
+
 // AppendRoute appends a route to routes.jsonl in the town's beads directory.
 // If the prefix already exists, it updates the path.
 func AppendRoute(townRoot string, route Route) error {
@@ -236,6 +304,7 @@ func ExtractPrefix(beadID string) string {
 }
 
 // GetRigPathForPrefix returns the rig path for a given bead ID prefix.
+// The prefix must match a route exactly; use GetRigPathForID for bead IDs.
 // The townRoot should be the Gas Town root directory (e.g., ~/gt).
 // Returns the full absolute path to the rig directory, or empty string if not found.
 // For town-level beads (path="."), returns townRoot.
@@ -246,19 +315,41 @@ func GetRigPathForPrefix(townRoot, prefix string) string {
 		return ""
 	}
 
-	for _, r := range routes {
-		if r.Prefix == prefix {
-			if r.Path == "." {
-				return townRoot // Town-level beads
-			}
-			return filepath.Join(townRoot, r.Path)
-		}
+	r, ok := findRoute(routes, prefix)
+	if !ok {
+		return ""
+	}
+	return routeRigPath(townRoot, r)
+}
+
+// GetRigPathForID returns the rig path for a full bead ID, such as
+// "gt-crew-bear". The highest-priority route whose prefix starts the ID
+// wins, so a "gt-crew-" route can claim IDs that "gt-" would otherwise take.
+// Returns empty string if no route matches.
+func GetRigPathForID(townRoot, beadID string) string {
+	beadsDir := filepath.Join(townRoot, ".beads")
+	routes, err := LoadRoutes(beadsDir)
+	if err != nil || routes == nil {
+		return ""
+	}
+
+	r, ok := matchRoute(routes, beadID)
+	if !ok {
+		return ""
 	}
+	return routeRigPath(townRoot, r)
+}
 
-	return ""
+// routeRigPath returns the absolute rig directory for r.
+func routeRigPath(townRoot string, r Route) string {
+	if r.Path == "." {
+		return townRoot // Town-level beads
+	}
+	return filepath.Join(townRoot, r.Path)
 }
 
 // GetRigNameForPrefix returns the rig name that owns a given bead prefix.
+// The prefix must match a route exactly; use GetRigNameForID for bead IDs.
 // For example, "gt-" returns "gastown", "bd-" returns "beads".
 // Returns empty string if the prefix is town-level (path=".") or not found in routes.
 func GetRigNameForPrefix(townRoot, prefix string) string {
@@ -268,19 +359,35 @@ func GetRigNameForPrefix(townRoot, prefix string) string {
 		return ""
 	}
 
-	for _, r := range routes {
-		if r.Prefix == prefix {
-			if r.Path == "." {
-				return "" // Town-level bead, no specific rig
-			}
-			parts := strings.SplitN(r.Path, "/", 2)
-			if len(parts) > 0 {
-				return parts[0]
-			}
-		}
+	r, ok := findRoute(routes, prefix)
+	if !ok {
+		return ""
+	}
+	return routeRigName(r)
+}
+
+// GetRigNameForID returns the rig name that owns a full bead ID, matched as
+// in GetRigPathForID. Returns empty string if the ID is town-level or unrouted.
+func GetRigNameForID(townRoot, beadID string) string {
+	beadsDir := filepath.Join(townRoot, ".beads")
+	routes, err := LoadRoutes(beadsDir)
+	if err != nil || routes == nil {
+		return ""
 	}
 
-	return ""
+	r, ok := matchRoute(routes, beadID)
+	if !ok {
+		return ""
+	}
+	return routeRigName(r)
+}
+
+// routeRigName returns the rig that r points at, or "" for a town-level route.
+func routeRigName(r Route) string {
+	if r.Path == "." {
+		return "" // Town-level bead with no specific rig
+	}
+	return strings.SplitN(r.Path, "/", 2)[0]
 }
 
 // ResolveBeadsDirForID resolves the correct .beads directory for a given bead ID
@@ -299,20 +406,15 @@ func ResolveBeadsDirForID(currentBeadsDir, beadID string) string {
 		return currentBeadsDir
 	}
 
-	for _, r := range routes {
-		if r.Prefix == prefix {
-			if r.Path == "." {
-				return currentBeadsDir // Town-level — already correct
-			}
-			// Rig-level bead — resolve to rig's beads directory.
-			// Derive town root from currentBeadsDir (parent of .beads).
-			townRoot := filepath.Dir(currentBeadsDir)
-			rigDir := filepath.Join(townRoot, r.Path)
-			return ResolveBeadsDir(rigDir)
-		}
+	r, ok := matchRoute(routes, beadID)
+	if !ok || r.Path == "." {
+		return currentBeadsDir // Unrouted or town-level — already correct
 	}
-
-	return currentBeadsDir
+	// Rig-level bead — resolve to rig's beads directory.
+	// Derive town root from currentBeadsDir (parent of .beads).
+	townRoot := filepath.Dir(currentBeadsDir)
+	rigDir := filepath.Join(townRoot, r.Path)
+	return ResolveBeadsDir(rigDir)
 }
 
 // ResolveHookDir determines the directory for running bd update on a bead.
@@ -321,8 +423,7 @@ func ResolveBeadsDirForID(currentBeadsDir, beadID string) string {
 // a fallback if prefix resolution fails.
 func ResolveHookDir(townRoot, beadID, hookWorkDir string) string {
 	// Always try prefix resolution first - bd update needs the actual rig dir
-	prefix := ExtractPrefix(beadID)
-	if rigPath := GetRigPathForPrefix(townRoot, prefix); rigPath != "" {
+	if rigPath := GetRigPathForID(townRoot, beadID); rigPath != "" {
 		return rigPath
 	}
 	// Fallback to hookWorkDir if provided
@@ -367,3 +367,91 @@ func TestAgentBeadIDsWithPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRigPathForPrefix_PriorityOrdering(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	routesContent := `{"prefix": "gt-", "path": "gastown/mayor/rig", "priority": 10}
+{"prefix": "gt-crew-", "path": "crew/mayor/rig", "priority": 5}
+`
+	if err := os.WriteFile(filepath.Join(beadsDir, "routes.jsonl"), []byte(routesContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	routes, err := LoadRoutes(beadsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(routes) != 2 || routes[0].Prefix != "gt-crew-" {
+		t.Fatalf("LoadRoutes should sort by priority, got %+v", routes)
+	}
+
+	if got, want := GetRigPathForID(tmpDir, "gt-crew-bear"), filepath.Join(tmpDir, "crew/mayor/rig"); got != want {
+		t.Errorf("GetRigPathForID(gt-crew-bear) = %q, want %q", got, want)
+	}
+	if got, want := GetRigPathForPrefix(tmpDir, "gt-"), filepath.Join(tmpDir, "gastown/mayor/rig"); got != want {
+		t.Errorf("GetRigPathForPrefix(gt-) = %q, want %q", got, want)
+	}
+}
+
+func TestValidateRoutes(t *testing.T) {
+	tests := []struct {
+		name    string
+		routes  []Route
+		wantErr int
+	}{
+		{"disjoint", []Route{{Prefix: "gt-", Path: "a"}, {Prefix: "bd-", Path: "b"}}, 0},
+		{"overlap same priority", []Route{{Prefix: "gt-", Path: "a"}, {Prefix: "gt-crew-", Path: "b"}}, 1},
+		{"overlap shadowed by priority", []Route{{Prefix: "gt-", Path: "a"}, {Prefix: "gt-crew-", Path: "b", Priority: 5}}, 1},
+		{"overlap resolved by priority", []Route{{Prefix: "gt-", Path: "a", Priority: 10}, {Prefix: "gt-crew-", Path: "b", Priority: 5}}, 0},
+		{"overlap same path", []Route{{Prefix: "hq-", Path: "."}, {Prefix: "hq-cv-", Path: "."}}, 0},
+		{"duplicate prefix", []Route{{Prefix: "gt-", Path: "a"}, {Prefix: "gt-", Path: "b"}}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := ValidateRoutes(tt.routes); len(errs) != tt.wantErr {
+				t.Errorf("ValidateRoutes() = %v, want %d error(s)", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetRigPathForPrefix_ExactMatchVersusID(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	// Same priority: ID lookups are first-match in file order, which
+	// ValidateRoutes flags. Prefix lookups only match a route exactly.
+	routesContent := `{"prefix": "gt-", "path": "gastown/mayor/rig"}
+{"prefix": "gt-crew-", "path": "crew/mayor/rig"}
+`
+	if err := os.WriteFile(filepath.Join(beadsDir, "routes.jsonl"), []byte(routesContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	gastown := filepath.Join(tmpDir, "gastown/mayor/rig")
+	crew := filepath.Join(tmpDir, "crew/mayor/rig")
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"prefix exact", GetRigPathForPrefix(tmpDir, "gt-crew-"), crew},
+		{"prefix unmatched", GetRigPathForPrefix(tmpDir, "gt-abc-"), ""},
+		{"prefix given an ID", GetRigPathForPrefix(tmpDir, "gt-abc"), ""},
+		{"ID first match", GetRigPathForID(tmpDir, "gt-crew-bear"), gastown},
+		{"ID unrouted", GetRigPathForID(tmpDir, "bd-abc"), ""},
+		{"rig name for ID", GetRigNameForID(tmpDir, "gt-abc"), "gastown"},
+		{"rig name for unmatched prefix", GetRigNameForPrefix(tmpDir, "gt-abc-"), ""},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
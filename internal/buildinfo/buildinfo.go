@@ -0,0 +1,151 @@
+// Package buildinfo exposes the running gt version and stamps persisted
+// runtime artifacts with provenance, so state written by one binary can be
+// recognized when a different binary loads it.
+//
+// The version and commit are wired from the cmd package's ldflags via Set.
+// This package has no internal dependencies so any writer can import it.
+package buildinfo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	version = "dev"
+	commit  = ""
+)
+
+// Set records the running binary's version and commit. Called once from
+// the cmd package, which receives them via ldflags.
+func Set(v, c string) {
+	if v != "" {
+		version = v
+	}
+	commit = c
+}
+
+// Version returns the running gt version.
+func Version() string {
+	return version
+}
+
+// Commit returns the commit the running gt was built from, if known.
+func Commit() string {
+	return commit
+}
+
+// Provenance records which binary wrote a runtime artifact and under which
+// configuration. It is embedded as a "provenance" field in JSON state files
+// and attached to header events in JSONL feeds.
+type Provenance struct {
+	GTVersion         string    `json:"gt_version"`
+	GTCommit          string    `json:"gt_commit,omitempty"`
+	ConfigFingerprint string    `json:"config_fingerprint,omitempty"`
+	WrittenAt         time.Time `json:"written_at"`
+}
+
+// Stamp returns provenance for an artifact being written now by this binary.
+func Stamp(configFingerprint string) *Provenance {
+	return &Provenance{
+		GTVersion:         version,
+		GTCommit:          shortCommit(commit),
+		ConfigFingerprint: configFingerprint,
+		WrittenAt:         time.Now().UTC(),
+	}
+}
+
+// ConfigFingerprint returns a short, stable hash of the given config files'
+// contents. Missing files contribute their absence, so adding or removing a
+// config changes the fingerprint. Returns "" when no paths are given.
+func ConfigFingerprint(paths ...string) string {
+	if len(paths) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	for _, p := range paths {
+		fmt.Fprintf(h, "%s\x00", p)
+		data, err := os.ReadFile(p) //nolint:gosec // G304: paths are gt config files
+		if err != nil {
+			h.Write([]byte("<absent>"))
+		} else {
+			h.Write(data)
+		}
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
+}
+
+// Mismatch reports whether p was written by a different binary than the
+// running one. Unstamped artifacts (nil p) are not mismatches.
+func Mismatch(p *Provenance) bool {
+	if p == nil {
+		return false
+	}
+	if p.GTVersion != version {
+		return true
+	}
+	return p.GTCommit != "" && commit != "" && p.GTCommit != shortCommit(commit)
+}
+
+var (
+	warnedMu sync.Mutex
+	warned   = map[string]bool{}
+
+	// warnOut is where mismatch notices are written. Tests replace it.
+	warnOut io.Writer = os.Stderr
+)
+
+// NoteLoaded is called by readers after loading an artifact. The first time
+// a file written by a different gt is seen in this process, an
+// informational notice is written to stderr; later loads of the same file
+// are silent. It returns whether the provenance mismatches.
+func NoteLoaded(path string, p *Provenance) bool {
+	if !Mismatch(p) {
+		return false
+	}
+	warnedMu.Lock()
+	defer warnedMu.Unlock()
+	if warned[path] {
+		return true
+	}
+	warned[path] = true
+	fmt.Fprintf(warnOut, "note: %s was written by gt %s (running %s)\n", path, describe(p.GTVersion, p.GTCommit), describe(version, shortCommit(commit)))
+	return true
+}
+
+func describe(v, c string) string {
+	if c == "" {
+		return v
+	}
+	return v + " (" + c + ")"
+}
+
+func shortCommit(c string) string {
+	if len(c) > 12 {
+		return c[:12]
+	}
+	return c
+}
+
+// SetNoticeOutput redirects mismatch notices and forgets which files have
+// already been reported. It returns a function restoring the previous
+// output. Intended for tests.
+func SetNoticeOutput(w io.Writer) (restore func()) {
+	warnedMu.Lock()
+	defer warnedMu.Unlock()
+	prev := warnOut
+	warnOut = w
+	warned = map[string]bool{}
+	return func() {
+		warnedMu.Lock()
+		defer warnedMu.Unlock()
+		warnOut = prev
+		warned = map[string]bool{}
+	}
+}
@@ -0,0 +1,104 @@
+package buildinfo
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// withVersion sets the running version/commit for the duration of a test.
+func withVersion(t *testing.T, v, c string) {
+	t.Helper()
+	oldV, oldC := version, commit
+	version, commit = v, c
+	t.Cleanup(func() { version, commit = oldV, oldC })
+}
+
+func TestStamp(t *testing.T) {
+	withVersion(t, "1.2.3", "0123456789abcdef0123")
+
+	p := Stamp("fp")
+	if p.GTVersion != "1.2.3" || p.GTCommit != "0123456789ab" || p.ConfigFingerprint != "fp" {
+		t.Errorf("Stamp() = %+v", p)
+	}
+	if p.WrittenAt.IsZero() {
+		t.Error("Stamp() did not set WrittenAt")
+	}
+	if Mismatch(p) {
+		t.Error("a fresh stamp must not mismatch the running binary")
+	}
+}
+
+func TestConfigFingerprint(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.json")
+	if err := os.WriteFile(a, []byte(`{"x":1}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fp1 := ConfigFingerprint(a)
+	if fp1 == "" || fp1 != ConfigFingerprint(a) {
+		t.Fatalf("fingerprint not stable: %q", fp1)
+	}
+	if err := os.WriteFile(a, []byte(`{"x":2}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if ConfigFingerprint(a) == fp1 {
+		t.Error("fingerprint did not change with file contents")
+	}
+	missing := filepath.Join(dir, "missing.json")
+	if ConfigFingerprint(a, missing) == ConfigFingerprint(a) {
+		t.Error("a missing file should still contribute to the fingerprint")
+	}
+	if ConfigFingerprint() != "" {
+		t.Error("no paths should give an empty fingerprint")
+	}
+}
+
+func TestMismatch(t *testing.T) {
+	withVersion(t, "1.2.3", "abc123")
+
+	tests := []struct {
+		name string
+		p    *Provenance
+		want bool
+	}{
+		{"unstamped", nil, false},
+		{"same version and commit", &Provenance{GTVersion: "1.2.3", GTCommit: "abc123"}, false},
+		{"same version, no commit recorded", &Provenance{GTVersion: "1.2.3"}, false},
+		{"older version", &Provenance{GTVersion: "1.2.2"}, true},
+		{"same version, different commit", &Provenance{GTVersion: "1.2.3", GTCommit: "def456"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Mismatch(tt.p); got != tt.want {
+				t.Errorf("Mismatch(%+v) = %v, want %v", tt.p, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNoteLoaded_OncePerFile(t *testing.T) {
+	withVersion(t, "1.2.3", "")
+	var buf bytes.Buffer
+	t.Cleanup(SetNoticeOutput(&buf))
+
+	old := &Provenance{GTVersion: "1.0.0"}
+	for i := 0; i < 3; i++ {
+		if !NoteLoaded("/town/a.json", old) {
+			t.Fatal("expected mismatch")
+		}
+	}
+	NoteLoaded("/town/b.json", old)
+	NoteLoaded("/town/c.json", &Provenance{GTVersion: "1.2.3"})
+
+	out := buf.String()
+	if n := strings.Count(out, "\n"); n != 2 {
+		t.Fatalf("expected 2 notices (one per mismatched file), got %d: %q", n, out)
+	}
+	if !strings.Contains(out, "/town/a.json was written by gt 1.0.0 (running 1.2.3)") {
+		t.Errorf("unexpected notice: %q", out)
+	}
+}
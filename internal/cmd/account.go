@@ -19,6 +19,7 @@ var (
 	accountJSON        bool
 	accountEmail       string
 	accountDescription string
+	accountOrgID       string
 )
 
 var accountCmd = &cobra.Command{
@@ -63,7 +64,8 @@ that directory to complete the login.
 Examples:
   gt account add work
   gt account add work --email steve@company.com
-  gt account add work --email steve@company.com --desc "Work account"`,
+  gt account add work --email steve@company.com --desc "Work account"
+  gt account add work --org-id 2f1c...         # Verify credentials belong to this org`,
 	Args: cobra.ExactArgs(1),
 	RunE: runAccountAdd,
 }
@@ -207,6 +209,7 @@ func runAccountAdd(cmd *cobra.Command, args []string) error {
 		Email:       accountEmail,
 		Description: accountDescription,
 		ConfigDir:   configDir,
+		OrgID:       accountOrgID,
 	}
 
 	// If this is the first account, make it default
@@ -509,6 +512,7 @@ func init() {
 
 	accountAddCmd.Flags().StringVar(&accountEmail, "email", "", "Account email address")
 	accountAddCmd.Flags().StringVar(&accountDescription, "desc", "", "Account description")
+	accountAddCmd.Flags().StringVar(&accountOrgID, "org-id", "", "Expected Anthropic organization UUID (enables identity cross-check in quota scan)")
 
 	// Add subcommands
 	accountCmd.AddCommand(accountListCmd)
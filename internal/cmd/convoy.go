@@ -1656,7 +1656,7 @@ func isSlingableBead(townRoot, beadID string) bool {
 	if prefix == "" {
 		return true // No prefix info, assume slingable
 	}
-	return beads.GetRigNameForPrefix(townRoot, prefix) != ""
+	return beads.GetRigNameForID(townRoot, beadID) != ""
 }
 
 // checkAndCloseCompletedConvoys finds open convoys where all tracked issues are closed
@@ -1542,7 +1542,7 @@ func rigFromBeadID(beadID string) string {
 	if err != nil {
 		return ""
 	}
-	return beads.GetRigNameForPrefix(townRoot, prefix)
+	return beads.GetRigNameForID(townRoot, beadID)
 }
 
 // beadsDirForID resolves the .beads directory that owns a given bead ID by
@@ -1558,7 +1558,7 @@ func beadsDirForID(beadID string) string {
 	if err != nil {
 		return ""
 	}
-	rigPath := beads.GetRigPathForPrefix(townRoot, prefix)
+	rigPath := beads.GetRigPathForID(townRoot, beadID)
 	if rigPath == "" {
 		return ""
 	}
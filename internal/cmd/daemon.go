@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/daemon"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/templates"
@@ -116,14 +117,22 @@ Uses copytruncate for Dolt server logs (safe for processes with open fds).
 daemon.log uses automatic lumberjack rotation and is skipped.
 
 By default, only rotates logs exceeding 100MB. Use --force to rotate all.
+Use --stats to report bytes rotated, compressed, and freed by cleanup.
+With --force, --rig limits rotation to that rig's .beads/dolt-server.log.
 
 Examples:
   gt daemon rotate-logs           # Rotate logs > 100MB
-  gt daemon rotate-logs --force   # Rotate all logs regardless of size`,
+  gt daemon rotate-logs --force   # Rotate all logs regardless of size
+  gt daemon rotate-logs --stats   # Also report disk space reclaimed
+  gt daemon rotate-logs --force --rig gastown   # Rotate one rig's Dolt log`,
 	RunE: runDaemonRotateLogs,
 }
 
-var daemonRotateLogsForce bool
+var (
+	daemonRotateLogsForce bool
+	daemonRotateLogsStats bool
+	daemonRotateLogsRig   string
+)
 
 var daemonClearBackoffCmd = &cobra.Command{
 	Use:   "clear-backoff <agent>",
@@ -160,6 +169,8 @@ func init() {
 	daemonLogsCmd.Flags().IntVarP(&daemonLogLines, "lines", "n", 50, "Number of lines to show")
 	daemonLogsCmd.Flags().BoolVarP(&daemonLogFollow, "follow", "f", false, "Follow log output")
 	daemonRotateLogsCmd.Flags().BoolVar(&daemonRotateLogsForce, "force", false, "Rotate all logs regardless of size")
+	daemonRotateLogsCmd.Flags().BoolVar(&daemonRotateLogsStats, "stats", false, "Report bytes rotated, compressed, and freed")
+	daemonRotateLogsCmd.Flags().StringVar(&daemonRotateLogsRig, "rig", "", "With --force, rotate only this rig's Dolt server log")
 
 	rootCmd.AddCommand(daemonCmd)
 }
@@ -188,6 +199,7 @@ func runDaemonStart(cmd *cobra.Command, args []string) error {
 
 	daemonCmd := exec.Command(gtPath, "daemon", "run")
 	daemonCmd.Dir = townRoot
+	daemonCmd.Env = daemon.Env(townRoot)
 
 	// Detach from terminal
 	daemonCmd.Stdin = nil
@@ -360,9 +372,17 @@ func runDaemonLogs(cmd *cobra.Command, args []string) error {
 }
 
 func runDaemonRun(cmd *cobra.Command, args []string) error {
-	townRoot, err := workspace.FindFromCwdOrError()
+	if err := daemon.ValidateDaemonEnv(os.Getenv); err != nil {
+		return fmt.Errorf("daemon pre-flight failed: %w", err)
+	}
+
+	cwd, err := os.Getwd()
 	if err != nil {
-		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		return fmt.Errorf("getting current directory: %w", err)
+	}
+	townRoot, err := daemonRunTownRoot(os.Getenv(config.EnvRoot), cwd)
+	if err != nil {
+		return fmt.Errorf("daemon pre-flight failed: %w", err)
 	}
 
 	config := daemon.DefaultConfig(townRoot)
@@ -374,6 +394,33 @@ func runDaemonRun(cmd *cobra.Command, args []string) error {
 	return d.Run()
 }
 
+// daemonRunTownRoot returns the town `gt daemon run` serves: root, the
+// GT_ROOT that ValidateDaemonEnv checked. A cwd inside a different town is
+// an error, since the daemon would otherwise validate one town and run
+// another. A cwd outside any town (e.g. under a supervisor) is fine.
+func daemonRunTownRoot(root, cwd string) (string, error) {
+	cwdTown, err := workspace.Find(cwd)
+	if err != nil || cwdTown == "" {
+		return root, nil
+	}
+	if !sameDir(cwdTown, root) {
+		return "", fmt.Errorf("%s=%s but the current directory is in town %s", config.EnvRoot, root, cwdTown)
+	}
+	return root, nil
+}
+
+// sameDir reports whether a and b name the same directory after resolving
+// symlinks.
+func sameDir(a, b string) bool {
+	resolve := func(p string) string {
+		if r, err := filepath.EvalSymlinks(p); err == nil {
+			return r
+		}
+		return filepath.Clean(p)
+	}
+	return resolve(a) == resolve(b)
+}
+
 func runDaemonEnableSupervisor(cmd *cobra.Command, args []string) error {
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
@@ -440,8 +487,17 @@ func runDaemonRotateLogs(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
+	if daemonRotateLogsRig != "" && !daemonRotateLogsForce {
+		return fmt.Errorf("--rig requires --force")
+	}
+
 	var result *daemon.RotateLogsResult
-	if daemonRotateLogsForce {
+	if daemonRotateLogsRig != "" {
+		result, err = daemon.ForceRotateRigLogs(townRoot, daemonRotateLogsRig)
+		if err != nil {
+			return err
+		}
+	} else if daemonRotateLogsForce {
 		result = daemon.ForceRotateLogs(townRoot)
 	} else {
 		result = daemon.RotateLogs(townRoot)
@@ -461,5 +517,14 @@ func runDaemonRotateLogs(cmd *cobra.Command, args []string) error {
 		fmt.Printf("%s No logs needed rotation\n", style.Bold.Render("✓"))
 	}
 
+	if daemonRotateLogsStats {
+		fmt.Printf("\nRotated:    %s\n", formatBytes(result.RotatedBytes))
+		fmt.Printf("Compressed: %s\n", formatBytes(result.CompressedBytes))
+		if result.Cleanup != nil {
+			fmt.Printf("Cleanup:    %s freed (%d stale, %d over budget)\n", formatBytes(result.Cleanup.BudgetSaved),
+				len(result.Cleanup.StaleRemoved), len(result.Cleanup.BudgetRemoved))
+		}
+	}
+
 	return nil
 }
@@ -41,3 +41,26 @@ func TestReadDaemonStartupFailure_MissingPIDReturnsEmpty(t *testing.T) {
 		t.Fatalf("readDaemonStartupFailure() = %q, want empty string", got)
 	}
 }
+
+func TestDaemonRunTownRoot(t *testing.T) {
+	newTown := func() string {
+		root := t.TempDir()
+		if err := os.MkdirAll(filepath.Join(root, "mayor"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, "mayor", "town.json"), []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return root
+	}
+	gtRoot, other := newTown(), newTown()
+
+	for _, cwd := range []string{gtRoot, filepath.Join(gtRoot, "mayor"), t.TempDir()} {
+		if got, err := daemonRunTownRoot(gtRoot, cwd); err != nil || got != gtRoot {
+			t.Errorf("daemonRunTownRoot(cwd=%s) = %q, %v; want %q", cwd, got, err, gtRoot)
+		}
+	}
+	if _, err := daemonRunTownRoot(gtRoot, other); err == nil {
+		t.Error("expected an error when the cwd is in a different town")
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -17,6 +18,10 @@ var (
 	doctorRestartSessions bool
 	doctorNoStart         bool
 	doctorSlow            string
+	doctorStopOnError     bool
+	doctorJSON            bool
+	doctorFilter          string
+	doctorFixOnly         string
 )
 
 var doctorCmd = &cobra.Command{
@@ -47,10 +52,13 @@ Infrastructure checks:
   - daemon                   Check if daemon is running (fixable)
   - boot-health              Check Boot watchdog health (vet mode)
   - town-beads-config        Verify town .beads/config.yaml exists (fixable)
+  - account-config           Verify Claude account config dirs and org IDs
 
 Cleanup checks (fixable):
   - orphan-sessions          Detect orphaned tmux sessions
   - orphan-processes         Detect orphaned Claude processes
+  - orphan-heartbeats        Detect stale heartbeat files for dead sessions
+  - daemon-disk              Check daemon/ size against its disk budget
   - session-name-format      Detect sessions with outdated naming format (fixable)
   - wisp-gc                  Detect and clean abandoned wisps (>1h)
   - misclassified-wisps      Detect issues that should be wisps (purges to wisps table, fixable)
@@ -112,10 +120,17 @@ Patrol checks:
   - patrol-not-stuck         Detect stale wisps (>1h)
   - patrol-plugins-accessible Verify plugin directories
 
-Use --fix to attempt automatic fixes for issues that support it.
+Use --fix to attempt automatic fixes for issues that support it. After
+fixing, every check is run again and the final state is shown.
+Use --fix-only <check-name> to fix a single check, e.g.
+  gt doctor --fix-only tmux-global-env
 Use --no-start with --fix to suppress starting the daemon and agents.
 Use --rig to check a specific rig instead of the entire workspace.
-Use --slow to highlight slow checks (default threshold: 1s, e.g. --slow=500ms).`,
+Use --slow to highlight slow checks (default threshold: 1s, e.g. --slow=500ms).
+Use --stop-on-error to run checks in category order and skip the checks
+that depend on infrastructure once an infrastructure check fails.
+Use --json for machine-readable results, optionally limited to one status:
+  gt doctor --json --filter error`,
 	RunE: runDoctor,
 }
 
@@ -126,12 +141,28 @@ func init() {
 	doctorCmd.Flags().BoolVar(&doctorRestartSessions, "restart-sessions", false, "Restart patrol sessions when fixing stale settings (use with --fix)")
 	doctorCmd.Flags().BoolVar(&doctorNoStart, "no-start", false, "Suppress starting daemon/agents during --fix")
 	doctorCmd.Flags().StringVar(&doctorSlow, "slow", "", "Highlight slow checks (optional threshold, default 1s)")
+	doctorCmd.Flags().BoolVar(&doctorStopOnError, "stop-on-error", false, "Skip later checks when an infrastructure check fails")
+	doctorCmd.Flags().BoolVar(&doctorJSON, "json", false, "Output check results as JSON")
+	doctorCmd.Flags().StringVar(&doctorFixOnly, "fix-only", "", "Run and fix only the named check")
+	doctorCmd.Flags().StringVar(&doctorFilter, "filter", "", "With --json, only output checks with this status (ok, warning, error)")
 	// Allow --slow without a value (uses default 1s)
 	doctorCmd.Flags().Lookup("slow").NoOptDefVal = "1s"
 	rootCmd.AddCommand(doctorCmd)
 }
 
 func runDoctor(cmd *cobra.Command, args []string) error {
+	var filter []doctor.CheckStatus
+	if doctorFilter != "" {
+		if !doctorJSON {
+			return fmt.Errorf("--filter requires --json")
+		}
+		status, err := doctor.ParseCheckStatus(doctorFilter)
+		if err != nil {
+			return fmt.Errorf("invalid --filter: %w", err)
+		}
+		filter = append(filter, status)
+	}
+
 	// Find town root
 	townRoot, err := workspace.FindFromCwdOrError()
 	if err != nil {
@@ -139,12 +170,16 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create check context
-	ctx := &doctor.CheckContext{
-		TownRoot:        townRoot,
-		RigName:         doctorRig,
-		Verbose:         doctorVerbose,
-		RestartSessions: doctorRestartSessions,
-		NoStart:         doctorNoStart,
+	ctx, err := doctor.NewCheckContext(townRoot)
+	if err != nil {
+		return err
+	}
+	ctx.RigName = doctorRig
+	ctx.Verbose = doctorVerbose
+	ctx.RestartSessions = doctorRestartSessions
+	ctx.NoStart = doctorNoStart
+	if doctorStopOnError {
+		ctx.StopOnCategory = doctor.CategoryInfrastructure
 	}
 
 	// Create doctor and register checks
@@ -175,6 +210,7 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	// its EnsureSettingsForRole sees stale files → returns early → sessions
 	// start with missing PATH exports. See gt-99u.
 	d.Register(doctor.NewClaudeSettingsCheck())
+	d.Register(doctor.NewAccountConfigCheck())
 	d.Register(doctor.NewDaemonCheck())
 	d.Register(doctor.NewTmuxGlobalEnvCheck())
 	d.Register(doctor.NewBootHealthCheck())
@@ -204,6 +240,9 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	d.Register(doctor.NewStaleBeadsRedirectCheck())
 	d.Register(doctor.NewBeadsRedirectTargetCheck())
 	d.Register(doctor.NewStaleRuntimeFilesCheck())
+	d.Register(doctor.NewOrphanHeartbeatCheck())
+	d.Register(doctor.NewDaemonDiskCheck())
+	d.Register(doctor.NewRuntimeProvenanceCheck())
 	d.Register(doctor.NewBranchCheck())
 	d.Register(doctor.NewCloneDivergenceCheck())
 	d.Register(doctor.NewDefaultBranchAllRigsCheck())
@@ -288,11 +327,34 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if doctorFixOnly != "" {
+		return runDoctorFixOnly(d, ctx, slowThreshold, filter)
+	}
+
+	if doctorJSON {
+		var report *doctor.Report
+		if doctorFix {
+			fixed := d.Fix(ctx)
+			report = recheckAfterFix(d, ctx, fixed, nil, 0)
+			carryFixResults(report, fixed)
+		} else {
+			report = d.Run(ctx)
+		}
+		if err := report.WriteJSON(os.Stdout, filter...); err != nil {
+			return err
+		}
+		if report.HasErrors() {
+			return NewSilentExit(1)
+		}
+		return nil
+	}
+
 	// Run checks with streaming output
 	fmt.Println() // Initial blank line
 	var report *doctor.Report
 	if doctorFix {
 		report = d.FixStreaming(ctx, os.Stdout, slowThreshold)
+		report = recheckAfterFix(d, ctx, report, os.Stdout, slowThreshold)
 	} else {
 		report = d.RunStreaming(ctx, os.Stdout, slowThreshold)
 	}
@@ -307,3 +369,73 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// recheckAfterFix prints the outcome of each fix attempted in report to w
+// and, if any were attempted, runs every check again so the summary reflects
+// the final state rather than the state while fixing. A nil w rechecks
+// without printing, for --json.
+func recheckAfterFix(d *doctor.Doctor, ctx *doctor.CheckContext, report *doctor.Report, w io.Writer, slowThreshold time.Duration) *doctor.Report {
+	if len(report.FixAttempts()) == 0 {
+		return report
+	}
+	if w != nil {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Fixes:")
+		report.PrintFixes(w)
+
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Final state:")
+	}
+	final := d.RunStreaming(ctx, w, slowThreshold)
+	final.Summary.Fixed = report.Summary.Fixed
+	return final
+}
+
+// carryFixResults copies each check's fix outcome from fixed onto the
+// matching result in final, so --json --fix output reports both the
+// rechecked status and what was fixed.
+func carryFixResults(final, fixed *doctor.Report) {
+	if final == fixed {
+		return
+	}
+	attempts := make(map[string]*doctor.CheckResult)
+	for _, check := range fixed.FixAttempts() {
+		attempts[check.Name] = check
+	}
+	for _, check := range final.Checks {
+		if a, ok := attempts[check.Name]; ok {
+			check.Fixed = a.Fixed
+			check.FixError = a.FixError
+		}
+	}
+}
+
+// runDoctorFixOnly runs and fixes the single check named by --fix-only.
+func runDoctorFixOnly(d *doctor.Doctor, ctx *doctor.CheckContext, slowThreshold time.Duration, filter []doctor.CheckStatus) error {
+	var w io.Writer = os.Stdout
+	if doctorJSON {
+		w = nil
+	} else {
+		fmt.Println()
+	}
+	report, err := d.FixOnly(ctx, doctorFixOnly, w, slowThreshold)
+	if err != nil {
+		return fmt.Errorf("--fix-only: %w", err)
+	}
+
+	if doctorJSON {
+		if err := report.WriteJSON(os.Stdout, filter...); err != nil {
+			return err
+		}
+	} else if len(report.FixAttempts()) > 0 {
+		fmt.Println()
+		report.PrintFixes(os.Stdout)
+	}
+	if report.HasErrors() {
+		if doctorJSON {
+			return NewSilentExit(1)
+		}
+		return fmt.Errorf("%s: %s", doctorFixOnly, report.Checks[0].Message)
+	}
+	return nil
+}
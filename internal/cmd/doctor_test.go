@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/doctor"
+)
+
+// flakyFixCheck warns until fixed and counts how often it runs.
+type flakyFixCheck struct {
+	doctor.FixableCheck
+	broken bool
+	runs   int
+}
+
+func (c *flakyFixCheck) Run(ctx *doctor.CheckContext) *doctor.CheckResult {
+	c.runs++
+	if c.broken {
+		return &doctor.CheckResult{Name: c.Name(), Status: doctor.StatusWarning, Message: "broken"}
+	}
+	return &doctor.CheckResult{Name: c.Name(), Status: doctor.StatusOK, Message: "ok"}
+}
+
+func (c *flakyFixCheck) Fix(ctx *doctor.CheckContext) error {
+	c.broken = false
+	return nil
+}
+
+func TestRecheckAfterFix_JSONReportsFinalStateAndFixes(t *testing.T) {
+	check := &flakyFixCheck{broken: true}
+	check.CheckName = "flaky"
+	d := doctor.NewDoctor()
+	d.Register(check)
+	ctx := &doctor.CheckContext{TownRoot: t.TempDir()}
+
+	fixed := d.Fix(ctx)
+	runsBefore := check.runs
+	report := recheckAfterFix(d, ctx, fixed, nil, 0)
+	carryFixResults(report, fixed)
+
+	if check.runs == runsBefore {
+		t.Fatal("recheckAfterFix with a nil writer did not re-run the checks")
+	}
+	if len(report.Checks) != 1 {
+		t.Fatalf("got %d checks, want 1", len(report.Checks))
+	}
+	got := report.Checks[0]
+	if got.Status != doctor.StatusOK || !got.Fixed {
+		t.Errorf("final result = status %v fixed %v, want ok and fixed", got.Status, got.Fixed)
+	}
+	if report.Summary.Fixed != 1 {
+		t.Errorf("Summary.Fixed = %d, want 1", report.Summary.Fixed)
+	}
+}
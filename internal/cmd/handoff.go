@@ -1089,7 +1089,7 @@ func sessionToGTRole(sessionName string) string {
 }
 
 // detectTownRootFromCwd walks up from the current directory to find the town root.
-// Falls back to GT_TOWN_ROOT or GT_ROOT env vars if cwd detection fails (broken state recovery).
+// Falls back to the GT_ROOT (or legacy GT_TOWN_ROOT) env var if cwd detection fails (broken state recovery).
 func detectTownRootFromCwd() string {
 	// Use workspace.FindFromCwd which handles both primary (mayor/town.json)
 	// and secondary (mayor/ directory) markers
@@ -1098,29 +1098,32 @@ func detectTownRootFromCwd() string {
 		return townRoot
 	}
 
-	// Fallback: try environment variables for town root
-	// GT_TOWN_ROOT is set by shell integration, GT_ROOT is set by session manager
+	// Fallback: try the town root environment variable.
+	// Shell integration and the session manager both set it.
 	// This enables handoff to work even when cwd detection fails due to
 	// detached HEAD, wrong branch, deleted worktree, etc.
-	for _, envName := range []string{"GT_TOWN_ROOT", "GT_ROOT"} {
-		if envRoot := os.Getenv(envName); envRoot != "" {
-			// Verify it's actually a workspace
-			if _, statErr := os.Stat(filepath.Join(envRoot, workspace.PrimaryMarker)); statErr == nil {
-				return envRoot
-			}
-			// Try secondary marker too
-			if info, statErr := os.Stat(filepath.Join(envRoot, workspace.SecondaryMarker)); statErr == nil && info.IsDir() {
-				return envRoot
-			}
+	if envRoot, _, _ := config.ResolveRootEnv(); envRoot != "" {
+		// Verify it's actually a workspace
+		if _, statErr := os.Stat(filepath.Join(envRoot, workspace.PrimaryMarker)); statErr == nil {
+			return envRoot
+		}
+		// Try secondary marker too
+		if info, statErr := os.Stat(filepath.Join(envRoot, workspace.SecondaryMarker)); statErr == nil && info.IsDir() {
+			return envRoot
 		}
 	}
 
-	// Final fallback: read GT_TOWN_ROOT from tmux global environment.
+	// Final fallback: read the town root from tmux global environment.
 	// This handles the run-shell case where CWD is $HOME and process env
-	// vars aren't set — the daemon sets GT_TOWN_ROOT in tmux global env.
+	// vars aren't set — the daemon sets GT_ROOT (and the legacy GT_TOWN_ROOT)
+	// in tmux global env. Older daemons only set the legacy name.
 	if socket := tmux.SocketFromEnv(); socket != "" {
 		t := tmux.NewTmuxWithSocket(socket)
-		if envRoot, err := t.GetGlobalEnvironment("GT_TOWN_ROOT"); err == nil && envRoot != "" {
+		for _, key := range []string{config.EnvRoot, config.EnvTownRootLegacy} {
+			envRoot, err := t.GetGlobalEnvironment(key)
+			if err != nil || envRoot == "" {
+				continue
+			}
 			if _, statErr := os.Stat(filepath.Join(envRoot, workspace.PrimaryMarker)); statErr == nil {
 				return envRoot
 			}
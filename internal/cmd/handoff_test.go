@@ -319,7 +319,7 @@ func TestDetectTownRootFromCwd_EnvFallback(t *testing.T) {
 		}
 	})
 
-	t.Run("prefers GT_TOWN_ROOT over GT_ROOT", func(t *testing.T) {
+	t.Run("prefers GT_ROOT over GT_TOWN_ROOT", func(t *testing.T) {
 		// Create another temp town for GT_ROOT
 		anotherTown := t.TempDir()
 		anotherMayor := filepath.Join(anotherTown, "mayor")
@@ -336,8 +336,8 @@ func TestDetectTownRootFromCwd_EnvFallback(t *testing.T) {
 		defer os.Chdir(origCwd)
 
 		result := detectTownRootFromCwd()
-		if result != tmpTown {
-			t.Errorf("detectTownRootFromCwd() = %q, want %q (should prefer GT_TOWN_ROOT)", result, tmpTown)
+		if result != anotherTown {
+			t.Errorf("detectTownRootFromCwd() = %q, want %q (GT_ROOT is authoritative; GT_TOWN_ROOT is legacy)", result, anotherTown)
 		}
 	})
 
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var (
+	logsListRig  string
+	logsListJSON bool
+)
+
+var logsCmd = &cobra.Command{
+	Use:     "logs",
+	GroupID: GroupDiag,
+	Short:   "Manage daemon and Dolt log files",
+	RunE:    requireSubcommand,
+}
+
+var logsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List active and rotated log files",
+	Long: `List the log files managed by log rotation.
+
+Shows the .log files and their compressed rotations under daemon/, and each
+rig's .beads/dolt-server.log with its rotations.
+
+Examples:
+  gt logs list                 # All managed logs
+  gt logs list --rig gastown   # Only the gastown rig's Dolt logs
+  gt logs list --json          # Machine-readable output`,
+	RunE: runLogsList,
+}
+
+func init() {
+	logsListCmd.Flags().StringVar(&logsListRig, "rig", "", "Only list logs belonging to this rig")
+	logsListCmd.Flags().BoolVar(&logsListJSON, "json", false, "Output as JSON")
+
+	logsCmd.AddCommand(logsListCmd)
+	rootCmd.AddCommand(logsCmd)
+}
+
+func runLogsList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	entries, err := daemon.LogInventory(townRoot)
+	if err != nil {
+		return fmt.Errorf("listing logs: %w", err)
+	}
+	if logsListRig != "" {
+		filtered := entries[:0]
+		for _, e := range entries {
+			if e.Rig == logsListRig {
+				filtered = append(filtered, e)
+			}
+		}
+		entries = filtered
+	}
+
+	if logsListJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	}
+	if len(entries) == 0 {
+		fmt.Println("No log files found.")
+		return nil
+	}
+	printLogInventory(os.Stdout, townRoot, entries)
+	return nil
+}
+
+// printLogInventory renders entries as a table with paths relative to
+// townRoot.
+func printLogInventory(out io.Writer, townRoot string, entries []daemon.LogEntry) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PATH\tSIZE\tMODIFIED\tKIND")
+	for _, e := range entries {
+		path := e.Path
+		if rel, err := filepath.Rel(townRoot, e.Path); err == nil {
+			path = rel
+		}
+		kind := "active"
+		if e.IsRotated {
+			kind = "rotated"
+			if e.Compression != "" {
+				kind += " (" + e.Compression + ")"
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", path, formatBytes(e.Size), e.ModTime.Format("2006-01-02 15:04"), kind)
+	}
+	_ = w.Flush()
+}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 )
 
@@ -20,11 +22,16 @@ var (
 	mailTo            string   // --to flag (alternative to positional arg)
 	mailSendSelf      bool
 	mailCC            []string // CC recipients
+	mailSendBatch     string   // --batch JSONL file
+	mailAwaitDelivery time.Duration // --await-delivery timeout (0 = don't wait)
 	mailInboxJSON     bool
 	mailReadJSON      bool
 	mailInboxUnread   bool
 	mailInboxAll      bool
 	mailInboxIdentity string
+	mailInboxFrom     string
+	mailInboxSubject  string
+	mailInboxPriority string
 	mailCheckInject   bool
 	mailCheckJSON     bool
 	mailCheckIdentity string
@@ -38,6 +45,7 @@ var (
 	mailSearchSubject bool
 	mailSearchBody    bool
 	mailSearchArchive bool
+	mailSearchRegex   bool
 	mailSearchJSON    bool
 
 	// Announces flags
@@ -47,8 +55,12 @@ var (
 	mailClearAll bool
 
 	// Archive flags
-	mailArchiveStale  bool
-	mailArchiveDryRun bool
+	mailArchiveStale     bool
+	mailArchiveDryRun    bool
+	mailArchiveOlderThan string
+
+	// Purge flags
+	mailPurgeArchived bool
 )
 
 var mailCmd = &cobra.Command{
@@ -134,26 +146,35 @@ Examples:
   gt mail send mayor/ -s "Re: Status" -m "Done" --reply-to msg-abc123
   gt mail send --self -s "Handoff" -m "Context for next session"
   gt mail send greenplace/Toast -s "Update" -m "Progress report" --cc overseer
+  gt mail send greenplace/Toast -s "Task" -m "Fix bug" --await-delivery=10m
   gt mail send list:oncall -s "Alert" -m "System down"
 
   # Read body from stdin (avoids shell quoting issues):
   gt mail send mayor/ -s "Update" --stdin <<'BODY'
   Message with 'quotes' and "quotes" and $variables.
-  BODY`,
+  BODY
+
+  # Send several messages from a JSONL file, one object per line:
+  #   {"to":"gastown/Toast","subject":"Status","body":"...","priority":"high"}
+  # Messages are sent as you ("from" is rejected), and ids must be unique.
+  # A failed message does not stop the rest; a status table is printed.
+  gt mail send --batch messages.jsonl`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runMailSend,
 }
 
 var mailInboxCmd = &cobra.Command{
-	Use:   "inbox [address]",
-	Short: "Check inbox",
+	Use:     "inbox [address]",
+	Aliases: []string{"list"},
+	Short:   "Check inbox",
 	Long: `Check messages in an inbox.
 
 If no address is specified, shows the current context's inbox.
 Use --identity for polecats to explicitly specify their identity.
 
 By default, shows all messages. Use --unread to filter to unread only,
-or --all to explicitly show all messages (read and unread).
+or --all to explicitly show all messages (read and unread). --from,
+--subject and --priority narrow the list further; all filters must match.
 
 Examples:
   gt mail inbox                       # Current context (auto-detected)
@@ -161,7 +182,8 @@ Examples:
   gt mail inbox --unread              # Show only unread messages
   gt mail inbox mayor/                # Mayor's inbox
   gt mail inbox greenplace/Toast         # Polecat's inbox
-  gt mail inbox --identity greenplace/Toast  # Explicit polecat identity`,
+  gt mail inbox --identity greenplace/Toast  # Explicit polecat identity
+  gt mail list --from mayor/ --unread --priority high`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runMailInbox,
 }
@@ -218,17 +240,36 @@ Removes the messages from your inbox by closing them in beads.
 
 Use --stale to archive messages sent before your current session started.
 
+Use --older-than to move read messages older than a duration (e.g. 7d, 12h)
+to the archive file. Unread and pinned messages are kept.
+
 Examples:
 	gt mail archive hq-abc123
 	gt mail archive hq-abc123 hq-def456 hq-ghi789
 	gt mail archive --stale
-	gt mail archive --stale --dry-run`,
+	gt mail archive --stale --dry-run
+	gt mail archive --older-than 7d`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		return nil
 	},
 	RunE: runMailArchive,
 }
 
+var mailPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Permanently delete archived messages",
+	Long: `Permanently delete messages from your mail archive.
+
+Messages reach the archive via 'gt mail archive --older-than'. The town
+archive is shared, so only messages addressed to you are deleted; other
+agents' archived mail is kept. Purging cannot be undone.
+
+Examples:
+	gt mail purge --archived`,
+	Args: cobra.NoArgs,
+	RunE: runMailPurge,
+}
+
 var mailMarkReadCmd = &cobra.Command{
 	Use:     "mark-read <message-id> [message-id...]",
 	Aliases: []string{"ack"},
@@ -400,10 +441,12 @@ var mailSearchCmd = &cobra.Command{
 SYNTAX:
   gt mail search <query> [flags]
 
-The query is a regular expression pattern. Search is case-insensitive by default.
+The query is matched as a literal substring; with --regex it is a regular
+expression. Search is case-insensitive.
 
 FLAGS:
   --from <sender>   Filter by sender address (substring match)
+  --regex           Treat the query as a regular expression
   --subject         Only search subject lines
   --body            Only search message body
   --archive         Include archived (closed) messages
@@ -412,11 +455,11 @@ FLAGS:
 By default, searches both subject and body text.
 
 Examples:
-  gt mail search "urgent"                    # Find messages with "urgent"
-  gt mail search "status.*check" --subject   # Regex in subjects only
-  gt mail search "error" --from witness      # From witness, containing "error"
-  gt mail search "handoff" --archive         # Include archived messages
-  gt mail search "" --from mayor/            # All messages from mayor`,
+  gt mail search "urgent"                          # Find messages with "urgent"
+  gt mail search "status.*check" --regex --subject # Regex in subjects only
+  gt mail search "error" --from witness            # From witness, containing "error"
+  gt mail search "handoff" --archive               # Include archived messages
+  gt mail search "" --from mayor/                  # All messages from mayor`,
 	Args: cobra.ExactArgs(1),
 	RunE: runMailSearch,
 }
@@ -472,7 +515,11 @@ func init() {
 	mailSendCmd.Flags().StringVar(&mailTo, "to", "", "Recipient address (alternative to positional argument)")
 	mailSendCmd.Flags().BoolVar(&mailSendSelf, "self", false, "Send to self (auto-detect from cwd)")
 	mailSendCmd.Flags().StringArrayVar(&mailCC, "cc", nil, "CC recipients (can be used multiple times)")
-	_ = mailSendCmd.MarkFlagRequired("subject") // cobra flags: error only at runtime if missing
+	mailSendCmd.Flags().StringVar(&mailSendBatch, "batch", "", "Send every message in a JSONL file (one message per line)")
+	mailSendCmd.Flags().DurationVar(&mailAwaitDelivery, "await-delivery", 0, "Wait until direct recipients read the message (default timeout 5m)")
+	// Allow --await-delivery without a value (uses default 5m)
+	mailSendCmd.Flags().Lookup("await-delivery").NoOptDefVal = "5m"
+	// --subject is required unless --batch is used; checked in runMailSend.
 
 	// Inbox flags
 	mailInboxCmd.Flags().BoolVar(&mailInboxJSON, "json", false, "Output as JSON")
@@ -480,6 +527,9 @@ func init() {
 	mailInboxCmd.Flags().BoolVarP(&mailInboxAll, "all", "a", false, "Show all messages (read and unread)")
 	mailInboxCmd.Flags().StringVar(&mailInboxIdentity, "identity", "", "Explicit identity for inbox (e.g., greenplace/Toast)")
 	mailInboxCmd.Flags().StringVar(&mailInboxIdentity, "address", "", "Alias for --identity")
+	mailInboxCmd.Flags().StringVar(&mailInboxFrom, "from", "", "Show only messages from this address")
+	mailInboxCmd.Flags().StringVar(&mailInboxSubject, "subject", "", "Show only messages whose subject contains this text")
+	mailInboxCmd.Flags().StringVar(&mailInboxPriority, "priority", "", "Show only messages with this priority (urgent, high, normal, low)")
 
 	// Read flags
 	mailReadCmd.Flags().BoolVar(&mailReadJSON, "json", false, "Output as JSON")
@@ -503,6 +553,7 @@ func init() {
 	mailSearchCmd.Flags().BoolVar(&mailSearchSubject, "subject", false, "Only search subject lines")
 	mailSearchCmd.Flags().BoolVar(&mailSearchBody, "body", false, "Only search message body")
 	mailSearchCmd.Flags().BoolVar(&mailSearchArchive, "archive", false, "Include archived messages")
+	mailSearchCmd.Flags().BoolVar(&mailSearchRegex, "regex", false, "Treat the query as a regular expression")
 	mailSearchCmd.Flags().BoolVar(&mailSearchJSON, "json", false, "Output as JSON")
 
 	// Announces flags
@@ -514,6 +565,10 @@ func init() {
 	// Archive flags
 	mailArchiveCmd.Flags().BoolVar(&mailArchiveStale, "stale", false, "Archive messages sent before session start")
 	mailArchiveCmd.Flags().BoolVarP(&mailArchiveDryRun, "dry-run", "n", false, "Show what would be archived without archiving")
+	mailArchiveCmd.Flags().StringVar(&mailArchiveOlderThan, "older-than", "", "Archive read messages older than this (e.g. 7d, 12h)")
+
+	// Purge flags
+	mailPurgeCmd.Flags().BoolVar(&mailPurgeArchived, "archived", false, "Delete your archived messages")
 
 	// Add subcommands
 	mailCmd.AddCommand(mailSendCmd)
@@ -522,6 +577,7 @@ func init() {
 	mailCmd.AddCommand(mailPeekCmd)
 	mailCmd.AddCommand(mailDeleteCmd)
 	mailCmd.AddCommand(mailArchiveCmd)
+	mailCmd.AddCommand(mailPurgeCmd)
 	mailCmd.AddCommand(mailMarkReadCmd)
 	mailCmd.AddCommand(mailMarkUnreadCmd)
 	mailCmd.AddCommand(mailCheckCmd)
@@ -32,11 +32,29 @@ func getMailbox(address string) (*mail.Mailbox, error) {
 	return mailbox, nil
 }
 
+// mailInboxFilter builds the message filter from the inbox flags. Unread
+// state is left nil because --unread already selects ListUnread.
+func mailInboxFilter() (mail.MailFilter, error) {
+	filter := mail.MailFilter{From: mailInboxFrom, Subject: mailInboxSubject}
+	if mailInboxPriority != "" {
+		p := mail.Priority(strings.ToLower(mailInboxPriority))
+		if mail.ParsePriority(string(p)) != p {
+			return filter, fmt.Errorf("invalid --priority %q (want urgent, high, normal, or low)", mailInboxPriority)
+		}
+		filter.Priority = p
+	}
+	return filter, nil
+}
+
 func runMailInbox(cmd *cobra.Command, args []string) error {
 	// Check for mutually exclusive flags
 	if mailInboxAll && mailInboxUnread {
 		return errors.New("--all and --unread are mutually exclusive")
 	}
+	filter, err := mailInboxFilter()
+	if err != nil {
+		return err
+	}
 
 	// Determine which inbox to check (priority: --identity flag, positional arg, auto-detect)
 	address := ""
@@ -65,6 +83,7 @@ func runMailInbox(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("listing messages: %w", err)
 	}
+	messages = mail.FilterMessages(messages, filter)
 	if messages == nil {
 		messages = make([]*mail.Message, 0)
 	}
@@ -325,14 +344,27 @@ func runMailArchive(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if mailArchiveStale && mailArchiveOlderThan != "" {
+		return errors.New("--stale cannot be combined with --older-than")
+	}
 	if mailArchiveStale {
 		if len(args) > 0 {
 			return errors.New("--stale cannot be combined with message IDs")
 		}
 		return runMailArchiveStale(mailbox, address)
 	}
+	if mailArchiveOlderThan != "" {
+		if len(args) > 0 {
+			return errors.New("--older-than cannot be combined with message IDs")
+		}
+		olderThan, err := parseDuration(mailArchiveOlderThan)
+		if err != nil {
+			return fmt.Errorf("invalid --older-than: %w", err)
+		}
+		return runMailArchiveOlderThan(mailbox, olderThan)
+	}
 	if len(args) == 0 {
-		return errors.New("message ID required unless using --stale")
+		return errors.New("message ID required unless using --stale or --older-than")
 	}
 	if mailArchiveDryRun {
 		fmt.Printf("%s Would archive %d message(s)\n", style.Dim.Render("(dry-run)"), len(args))
@@ -371,6 +403,67 @@ func runMailArchive(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runMailArchiveOlderThan moves read messages older than olderThan to the
+// mailbox archive file.
+func runMailArchiveOlderThan(mailbox *mail.Mailbox, olderThan time.Duration) error {
+	messages, err := mailbox.List()
+	if err != nil {
+		return fmt.Errorf("listing messages: %w", err)
+	}
+
+	toArchive := mail.ArchiveReadMessages(messages, olderThan)
+	if len(toArchive) == 0 {
+		fmt.Printf("%s No read messages older than %s\n", style.Success.Render("✓"), mailArchiveOlderThan)
+		return nil
+	}
+	if mailArchiveDryRun {
+		fmt.Printf("%s Would archive %d message(s):\n", style.Dim.Render("(dry-run)"), len(toArchive))
+		for _, msg := range toArchive {
+			fmt.Printf("  %s %s\n", style.Dim.Render(msg.ID), msg.Subject)
+		}
+		return nil
+	}
+
+	archived := 0
+	var errors []string
+	for _, msg := range toArchive {
+		if err := mailbox.Archive(msg.ID); err != nil {
+			errors = append(errors, fmt.Sprintf("%s: %v", msg.ID, err))
+		} else {
+			archived++
+		}
+	}
+
+	if len(errors) > 0 {
+		fmt.Printf("%s Archived %d/%d messages\n", style.Bold.Render("⚠"), archived, len(toArchive))
+		for _, e := range errors {
+			fmt.Printf("  Error: %s\n", e)
+		}
+		return fmt.Errorf("failed to archive %d messages", len(errors))
+	}
+
+	fmt.Printf("%s Archived %d message(s) to %s\n", style.Bold.Render("✓"), archived, mailbox.ArchivePath())
+	return nil
+}
+
+func runMailPurge(cmd *cobra.Command, args []string) error {
+	if !mailPurgeArchived {
+		return errors.New("nothing to purge: pass --archived")
+	}
+
+	mailbox, err := getMailbox(detectSender())
+	if err != nil {
+		return err
+	}
+
+	purged, err := mailbox.PurgeOwnArchive()
+	if err != nil {
+		return fmt.Errorf("purging archive: %w", err)
+	}
+	fmt.Printf("%s Purged %d archived message(s)\n", style.Bold.Render("✓"), purged)
+	return nil
+}
+
 type staleMessage struct {
 	Message *mail.Message
 	Reason  string
@@ -33,6 +33,7 @@ func runMailSearch(cmd *cobra.Command, args []string) error {
 	// Build search options
 	opts := mail.SearchOptions{
 		Query:       query,
+		Regex:       mailSearchRegex,
 		FromFilter:  mailSearchFrom,
 		SubjectOnly: mailSearchSubject,
 		BodyOnly:    mailSearchBody,
@@ -8,6 +8,8 @@ import (
 	"io"
 	"os"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
@@ -18,6 +20,19 @@ import (
 )
 
 func runMailSend(cmd *cobra.Command, args []string) error {
+	if mailSendBatch != "" {
+		if len(args) > 0 || mailTo != "" || mailSendSelf {
+			return fmt.Errorf("--batch takes recipients from the file; do not pass an address")
+		}
+		if mailAwaitDelivery > 0 {
+			return fmt.Errorf("--await-delivery cannot be used with --batch")
+		}
+		return runMailSendBatch(mailSendBatch)
+	}
+	if mailSubject == "" {
+		return fmt.Errorf(`required flag(s) "subject" not set`)
+	}
+
 	// Handle --stdin: read message body from stdin (avoids shell quoting issues)
 	if mailStdin {
 		if mailBody != "" {
@@ -149,15 +164,7 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 			return err
 		}
 		// Fall back to legacy routing for infrastructure errors (beads down, etc.)
-		router := mail.NewRouter(workDir)
-		defer router.WaitPendingNotifications()
-		if err := router.Send(msg); err != nil {
-			return fmt.Errorf("sending message: %w", err)
-		}
-		_ = events.LogFeed(events.TypeMail, from, events.MailPayload(to, mailSubject))
-		fmt.Printf("%s Message sent to %s\n", style.Bold.Render("✓"), to)
-		fmt.Printf("  Subject: %s\n", mailSubject)
-		return nil
+		return sendMailLegacy(workDir, msg, mailAwaitDelivery)
 	}
 
 	// Route based on recipient type, collecting errors instead of failing early
@@ -165,6 +172,7 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 	defer router.WaitPendingNotifications()
 	var recipientAddrs []string
 	var sendErrs []string
+	var directIDs []string // bead IDs of direct copies, for --await-delivery
 
 	for _, rec := range recipients {
 		switch rec.Type {
@@ -196,6 +204,9 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 				continue
 			}
 			recipientAddrs = append(recipientAddrs, rec.Address)
+			if !mail.IsFanOutAddress(rec.Address) { // list: and announce: pass through as agents
+				directIDs = append(directIDs, msgCopy.ID)
+			}
 		}
 	}
 
@@ -224,6 +235,48 @@ func runMailSend(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  Type: %s\n", msg.Type)
 	}
 
+	return awaitMailDelivery(router, directIDs, mailAwaitDelivery)
+}
+
+// sendMailLegacy sends msg through the router's own address handling, used
+// when the resolver is unavailable. Fan-out addresses leave msg.ID as a
+// tracking ID rather than a bead, so --await-delivery is refused for them.
+func sendMailLegacy(workDir string, msg *mail.Message, awaitTimeout time.Duration) error {
+	if awaitTimeout > 0 && mail.IsFanOutAddress(msg.To) {
+		return fmt.Errorf("--await-delivery needs a direct recipient; %s is a list, queue, channel, or group address", msg.To)
+	}
+	router := mail.NewRouter(workDir)
+	defer router.WaitPendingNotifications()
+	if err := router.Send(msg); err != nil {
+		return fmt.Errorf("sending message: %w", err)
+	}
+	_ = events.LogFeed(events.TypeMail, msg.From, events.MailPayload(msg.To, msg.Subject))
+	fmt.Printf("%s Message sent to %s\n", style.Bold.Render("✓"), msg.To)
+	fmt.Printf("  Subject: %s\n", msg.Subject)
+	return awaitMailDelivery(router, []string{msg.ID}, awaitTimeout)
+}
+
+// awaitMailDelivery waits for each delivered message to be read, sharing one
+// timeout across all of them. A zero timeout returns immediately. Fan-out
+// messages (queues, channels, lists) are not awaited: they have no single
+// reader.
+func awaitMailDelivery(router *mail.Router, ids []string, timeout time.Duration) error {
+	if timeout <= 0 || len(ids) == 0 {
+		return nil
+	}
+	for _, id := range ids {
+		if id == "" || mail.IsTrackingID(id) {
+			return fmt.Errorf("awaiting delivery: bd did not report a bead ID for message %s", id)
+		}
+	}
+	fmt.Printf("  Awaiting delivery (timeout %s)...\n", timeout)
+	deadline := time.Now().Add(timeout)
+	for _, id := range ids {
+		if err := router.AwaitDelivery(id, time.Until(deadline)); err != nil {
+			return fmt.Errorf("awaiting delivery: %w", err)
+		}
+	}
+	fmt.Printf("%s Delivered: %s\n", style.Bold.Render("✓"), strings.Join(ids, ", "))
 	return nil
 }
 
@@ -233,3 +286,58 @@ func generateThreadID() string {
 	_, _ = rand.Read(b) // crypto/rand.Read only fails on broken system
 	return "thread-" + hex.EncodeToString(b)
 }
+
+// runMailSendBatch sends every message in a JSONL file and prints a
+// per-message status table. Failed sends do not stop the batch.
+func runMailSendBatch(path string) error {
+	f, err := os.Open(path) //nolint:gosec // G304: path is user-provided on the command line
+	if err != nil {
+		return fmt.Errorf("opening batch file: %w", err)
+	}
+	defer f.Close()
+
+	workDir, err := findMailWorkDir()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	from := detectSender()
+
+	messages, err := mail.ParseBatch(f, from)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if len(messages) == 0 {
+		fmt.Println("No messages in batch.")
+		return nil
+	}
+	for _, msg := range messages {
+		if mailNoNotify {
+			msg.SuppressNotify = true
+		}
+	}
+
+	router := mail.NewRouter(workDir)
+	defer router.WaitPendingNotifications()
+	result := mail.BatchSend(messages, router)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTO\tSUBJECT\tSTATUS")
+	for _, msg := range messages {
+		status := style.Success.Render("sent")
+		if err := result[msg.ID]; err != nil {
+			status = style.Error.Render("failed: " + err.Error())
+		} else {
+			_ = events.LogFeed(events.TypeMail, msg.From, events.MailPayload(msg.To, msg.Subject))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", msg.ID, msg.To, msg.Subject, status)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if failed := result.Failed(); failed > 0 {
+		return fmt.Errorf("%d of %d message(s) failed to send", failed, len(messages))
+	}
+	fmt.Printf("%s Sent %d message(s)\n", style.Bold.Render("✓"), len(messages))
+	return nil
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/mail"
+)
+
+func TestSendMailLegacy_RejectsAwaitForFanOutAddresses(t *testing.T) {
+	workDir := t.TempDir()
+	for _, to := range []string{"list:oncall", "queue:work", "announce:news", "channel:alerts", "@town"} {
+		msg := mail.NewMessage("mayor/", to, "subject", "body")
+		err := sendMailLegacy(workDir, msg, time.Minute)
+		if err == nil || !strings.Contains(err.Error(), "--await-delivery") {
+			t.Errorf("sendMailLegacy(%s) = %v, want an --await-delivery error", to, err)
+		}
+	}
+}
+
+func TestAwaitMailDelivery_RejectsTrackingIDs(t *testing.T) {
+	// A tracking ID means bd never reported the bead; waiting on it could
+	// only time out.
+	for _, id := range []string{mail.GenerateID(), ""} {
+		if err := awaitMailDelivery(nil, []string{id}, time.Minute); err == nil {
+			t.Errorf("awaitMailDelivery(%q) succeeded, want an error", id)
+		}
+	}
+	if err := awaitMailDelivery(nil, []string{mail.GenerateID()}, 0); err != nil {
+		t.Errorf("zero timeout should not wait: %v", err)
+	}
+}
@@ -100,7 +100,7 @@ logic and runs directly in the current terminal.
 
 Environment variable overrides:
   GT_RIG          - Override rig name
-  GT_TOWN_ROOT    - Override town root directory
+  GT_ROOT         - Override town root directory (GT_TOWN_ROOT is a deprecated alias)
   GT_ROLE         - Override role (default: mayor)
 
 The agent reads prompts from stdin and outputs to stdout. This enables
@@ -129,7 +129,7 @@ func init() {
 	mayorRestartCmd.Flags().StringVar(&mayorAgentOverride, "agent", "", "Agent alias to run the Mayor with (overrides town default)")
 
 	mayorAcpCmd.Flags().StringVar(&acpRigOverride, "rig", "", "Rig name (overrides GT_RIG env)")
-	mayorAcpCmd.Flags().StringVar(&acpTownRootOverride, "town", "", "Town root directory (overrides GT_TOWN_ROOT env)")
+	mayorAcpCmd.Flags().StringVar(&acpTownRootOverride, "town", "", "Town root directory (overrides GT_ROOT env)")
 	mayorAcpCmd.Flags().StringVar(&mayorAgentOverride, "agent", "", "Agent alias to run (overrides town default)")
 
 	rootCmd.AddCommand(mayorCmd)
@@ -450,7 +450,7 @@ func runMayorAcp(cmd *cobra.Command, args []string) error {
 
 	townRoot := acpTownRootOverride
 	if townRoot == "" {
-		townRoot = os.Getenv("GT_TOWN_ROOT")
+		townRoot, _, _ = config.ResolveRootEnv()
 	}
 	if townRoot == "" {
 		var err error
@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/quota"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/witness"
 	"github.com/steveyegge/gastown/internal/workspace"
@@ -71,21 +72,24 @@ type PatrolScanOutput struct {
 
 // PatrolScanZombieOutput holds zombie detection results.
 type PatrolScanZombieOutput struct {
-	Checked int                    `json:"checked"`
-	Found   int                    `json:"found"`
-	Zombies []PatrolScanZombieItem `json:"zombies,omitempty"`
-	Errors  []string               `json:"errors,omitempty"`
+	Checked     int                    `json:"checked"`
+	Found       int                    `json:"found"`        // excludes rate-limited polecats
+	RateLimited int                    `json:"rate_limited"` // polecats waiting for a quota reset (listed in Zombies)
+	Zombies     []PatrolScanZombieItem `json:"zombies,omitempty"`
+	Errors      []string               `json:"errors,omitempty"`
 }
 
 // PatrolScanZombieItem is a single zombie detection in scan output.
 type PatrolScanZombieItem struct {
 	Polecat        string `json:"polecat"`
 	Classification string `json:"classification"`
+	Severity       int    `json:"severity"`
 	AgentState     string `json:"agent_state"`
 	HookBead       string `json:"hook_bead,omitempty"`
 	CleanupStatus  string `json:"cleanup_status,omitempty"`
 	Action         string `json:"action"`
 	WasActive      bool   `json:"was_active"`
+	ResetsAt       string `json:"resets_at,omitempty"`
 	Error          string `json:"error,omitempty"`
 }
 
@@ -148,20 +152,38 @@ func runPatrolScan(cmd *cobra.Command, args []string) error {
 
 	timestamp := time.Now().UTC().Format(time.RFC3339)
 
+	// Patrol activity is always appended to the rig's patrol feed so
+	// `gt witness watch` can follow it live. Appends are best-effort.
+	feed := witness.NewPatrolFeed(townRoot, rigName)
+	feed.Started()
+
 	// Run all three detection passes.
 	// Note: DetectZombiePolecats takes a router param but does NOT send mail
 	// internally — it only uses the router for workspace context. Notifications
 	// are sent exclusively below via --notify, avoiding double-send.
-	zombieResult := witness.DetectZombiePolecats(bd, workDir, rigName, router)
+	//
+	// Polecats the quota dog's latest scan shows as rate-limited are left
+	// alone: restarting them loses context and they come back still limited.
+	zombieStart := time.Now()
+	zombieResult := witness.DetectZombiePolecatsWithQuota(bd, workDir, rigName, router, patrolQuotaState(townRoot))
+	zombieDuration := time.Since(zombieStart)
 	stallResult := witness.DetectStalledPolecats(workDir, rigName)
 	completionResult := witness.DiscoverCompletions(bd, workDir, rigName, router)
+	feed.Record(zombieResult, stallResult, completionResult)
 
 	// Build patrol receipts for zombies
-	receipts := witness.BuildPatrolReceipts(rigName, zombieResult)
+	receipts := witness.BuildPatrolReceiptsWithDuration(rigName, zombieResult, zombieDuration)
+
+	// Persist the summary for `gt witness status` (best-effort).
+	summary := witness.SummarizePatrol(receipts)
+	if err := witness.SavePatrolSummary(witness.PatrolSummaryPath(townRoot, rigName), summary); err != nil {
+		style.PrintWarning("saving patrol summary: %v", err)
+	}
 
 	// Send notifications only when explicitly requested via --notify.
 	// The library detection functions do not send mail themselves.
-	if patrolScanNotify && zombieResult != nil {
+	// A clean patrol has nothing to report, so skip the follow-up entirely.
+	if patrolScanNotify && !summary.IsClean() && zombieResult != nil {
 		activeZombies := countActiveWorkZombies(zombieResult)
 		if activeZombies > 0 {
 			sendZombieNotification(router, rigName, zombieResult, activeZombies)
@@ -175,10 +197,20 @@ func runPatrolScan(cmd *cobra.Command, args []string) error {
 	return outputPatrolScanHuman(rigName, zombieResult, stallResult, completionResult, receipts)
 }
 
+// patrolQuotaState returns the fresh cached quota scan, or nil if there is
+// none, in which case zombie detection ignores quota.
+func patrolQuotaState(townRoot string) witness.QuotaStateProvider {
+	cache, err := quota.NewManager(townRoot).LoadScanCache()
+	if err != nil || !cache.Fresh(time.Now(), quota.DefaultScanCacheMaxAge) {
+		return nil
+	}
+	return cache
+}
+
 func countActiveWorkZombies(result *witness.DetectZombiePolecatsResult) int {
 	count := 0
 	for _, z := range result.Zombies {
-		if z.WasActive {
+		if z.WasActive && z.Classification != witness.ZombiePending {
 			count++
 		}
 	}
@@ -190,7 +222,7 @@ func sendZombieNotification(router *mail.Router, rigName string, result *witness
 	lines = append(lines, fmt.Sprintf("Patrol scan detected %d zombie(s) with active work in rig %s:", activeCount, rigName))
 	lines = append(lines, "")
 	for _, z := range result.Zombies {
-		if !z.WasActive {
+		if !z.WasActive || z.Classification == witness.ZombiePending {
 			continue
 		}
 		line := fmt.Sprintf("- %s: %s (hook=%s, action=%s)",
@@ -224,18 +256,21 @@ func outputPatrolScanJSON(rigName, timestamp string, zombieResult *witness.Detec
 	// Zombies
 	if zombieResult != nil {
 		zo := &PatrolScanZombieOutput{
-			Checked: zombieResult.Checked,
-			Found:   len(zombieResult.Zombies),
+			Checked:     zombieResult.Checked,
+			Found:       len(zombieResult.Zombies) - zombieResult.RateLimited(),
+			RateLimited: zombieResult.RateLimited(),
 		}
 		for _, z := range zombieResult.Zombies {
 			item := PatrolScanZombieItem{
 				Polecat:        z.PolecatName,
 				Classification: string(z.Classification),
+				Severity:       z.Severity,
 				AgentState:     z.AgentState,
 				HookBead:       z.HookBead,
 				CleanupStatus:  z.CleanupStatus,
 				Action:         z.Action,
 				WasActive:      z.WasActive,
+				ResetsAt:       z.ResetsAt,
 			}
 			if z.Error != nil {
 				item.Error = z.Error.Error()
@@ -295,7 +330,7 @@ func outputPatrolScanJSON(rigName, timestamp string, zombieResult *witness.Detec
 	return enc.Encode(output)
 }
 
-func outputPatrolScanHuman(rigName string, zombieResult *witness.DetectZombiePolecatsResult, stallResult *witness.DetectStalledPolecatsResult, completionResult *witness.DiscoverCompletionsResult, _ []witness.PatrolReceipt) error {
+func outputPatrolScanHuman(rigName string, zombieResult *witness.DetectZombiePolecatsResult, stallResult *witness.DetectStalledPolecatsResult, completionResult *witness.DiscoverCompletionsResult, receipts []witness.PatrolReceipt) error {
 	fmt.Printf("%s Patrol scan: %s\n\n", style.Bold.Render("🔍"), rigName)
 
 	// Zombies
@@ -321,6 +356,9 @@ func outputPatrolScanHuman(rigName string, zombieResult *witness.DetectZombiePol
 				}
 				fmt.Println()
 				fmt.Printf("    Action: %s\n", z.Action)
+				if z.ResetsAt != "" {
+					fmt.Printf("    Resets: %s\n", z.ResetsAt)
+				}
 				if z.Error != nil {
 					fmt.Printf("    %s\n", style.Dim.Render(fmt.Sprintf("Error: %v", z.Error)))
 				}
@@ -337,6 +375,13 @@ func outputPatrolScanHuman(rigName string, zombieResult *witness.DetectZombiePol
 		if len(zombieResult.ConvoyFailures) > 0 {
 			fmt.Printf("  Convoy failures: %d\n", len(zombieResult.ConvoyFailures))
 		}
+
+		if len(receipts) > 0 && patrolScanVerbose {
+			fmt.Printf("  Receipts:\n")
+			for _, r := range receipts {
+				fmt.Printf("    %s\n", r.EvidenceString())
+			}
+		}
 		fmt.Println()
 	}
 
@@ -384,8 +429,10 @@ func outputPatrolScanHuman(rigName string, zombieResult *witness.DetectZombiePol
 	// Summary
 	zombieCount := 0
 	activeCount := 0
+	rateLimitedCount := 0
 	if zombieResult != nil {
-		zombieCount = len(zombieResult.Zombies)
+		rateLimitedCount = zombieResult.RateLimited()
+		zombieCount = len(zombieResult.Zombies) - rateLimitedCount
 		activeCount = countActiveWorkZombies(zombieResult)
 	}
 	stallCount := 0
@@ -403,6 +450,9 @@ func outputPatrolScanHuman(rigName string, zombieResult *witness.DetectZombiePol
 		fmt.Printf("Summary: %d zombie(s) (%d active-work), %d stall(s), %d completion(s)\n",
 			zombieCount, activeCount, stallCount, completionCount)
 	}
+	if rateLimitedCount > 0 {
+		fmt.Printf("%d polecat(s) rate-limited, waiting for reset\n", rateLimitedCount)
+	}
 
 	return nil
 }
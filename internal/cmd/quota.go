@@ -3,12 +3,15 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"maps"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"slices"
 	"strings"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -47,7 +50,10 @@ Commands:
   gt quota status            Show account quota status
   gt quota scan              Detect rate-limited sessions
   gt quota rotate            Swap blocked sessions to available accounts
-  gt quota clear             Mark account(s) as available again`,
+  gt quota clear             Mark account(s) as available again
+  gt quota assert            Exit 0 if a session/account can take work
+  gt quota runtime gc        Prune and quarantine quota state files
+  gt quota org-id-extract    Print the org UUID a config dir is logged into`,
 }
 
 var quotaStatusCmd = &cobra.Command{
@@ -143,6 +149,24 @@ func printQuotaStatusJSON(acctCfg *config.AccountsConfig, state *config.QuotaSta
 	return enc.Encode(items)
 }
 
+// describeReset renders a stored reset time as "resets in 2h15m", falling
+// back to "resets <raw>" when the time cannot be parsed.
+func describeReset(resetsAt string) string {
+	t, ok := quota.ParseResetTimeAbsolute(resetsAt)
+	if !ok {
+		return "resets " + resetsAt
+	}
+	d := time.Until(t).Round(time.Minute)
+	switch {
+	case d < time.Minute:
+		return "resets in <1m"
+	case d < time.Hour:
+		return fmt.Sprintf("resets in %dm", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("resets in %dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+}
+
 func printQuotaStatusText(acctCfg *config.AccountsConfig, state *config.QuotaState) error {
 	available := 0
 	limited := 0
@@ -174,7 +198,7 @@ func printQuotaStatusText(acctCfg *config.AccountsConfig, state *config.QuotaSta
 			badge = style.Error.Render("limited")
 			limited++
 			if qs.ResetsAt != "" {
-				badge += style.Dim.Render(" (resets " + qs.ResetsAt + ")")
+				badge += style.Dim.Render(" (" + describeReset(qs.ResetsAt) + ")")
 			}
 		case config.QuotaStatusCooldown:
 			badge = style.Warning.Render("cooldown")
@@ -200,7 +224,10 @@ func printQuotaStatusText(acctCfg *config.AccountsConfig, state *config.QuotaSta
 
 // Scan command flags
 var (
-	scanUpdate bool
+	scanUpdate        bool
+	scanBlockedOnly   bool
+	scanNearLimitOnly bool
+	scanSummary       bool
 )
 
 var quotaScanCmd = &cobra.Command{
@@ -210,16 +237,46 @@ var quotaScanCmd = &cobra.Command{
 
 Captures recent pane output from each session and checks for rate-limit
 messages. Reports which sessions are blocked and which account they use.
+To replace the built-in rate-limit patterns, set quota.rate_limit_pattern_file
+in settings/config.json; gt quota rotate and quota_dog use the same file.
 
 Use --update to automatically update quota state with detected limits.
 
+--blocked-only and --near-limit-only restrict the output to rate-limited or
+near-limit sessions (both together show either). --summary prints one line
+per account with the worst status across its sessions.
+
 Examples:
-  gt quota scan              # Report rate-limited sessions
-  gt quota scan --update     # Report and update quota state
-  gt quota scan --json       # JSON output`,
+  gt quota scan                  # Report rate-limited sessions
+  gt quota scan --update         # Report and update quota state
+  gt quota scan --json           # JSON output
+  gt quota scan --blocked-only   # Only rate-limited sessions
+  gt quota scan --summary        # One line per account`,
 	RunE: runQuotaScan,
 }
 
+// newQuotaScanner creates a scanner for townRoot. When town settings name a
+// rate-limit pattern file, its patterns replace the built-in ones and are
+// reloaded on each scan if the file changes.
+func newQuotaScanner(townRoot string, t quota.TmuxClient, acctCfg *config.AccountsConfig) (*quota.Scanner, error) {
+	scanner, err := quota.NewScanner(t, nil, acctCfg)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := config.LoadOrCreateTownSettings(config.TownSettingsPath(townRoot))
+	if err != nil || settings.Quota == nil || settings.Quota.RateLimitPatternFile == "" {
+		return scanner, nil
+	}
+	path := settings.Quota.RateLimitPatternFile
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(townRoot, path)
+	}
+	if err := scanner.WithPatternFile(path); err != nil {
+		return nil, fmt.Errorf("loading rate-limit patterns: %w", err)
+	}
+	return scanner, nil
+}
+
 func runQuotaScan(cmd *cobra.Command, args []string) error {
 	townRoot, err := workspace.FindFromCwd()
 	if err != nil {
@@ -232,8 +289,7 @@ func runQuotaScan(cmd *cobra.Command, args []string) error {
 	// acctCfg can be nil if no accounts configured — scan still works
 
 	// Create scanner
-	t := ttmux.NewTmux()
-	scanner, err := quota.NewScanner(t, nil, acctCfg)
+	scanner, err := newQuotaScanner(townRoot, ttmux.NewTmux(), acctCfg)
 	if err != nil {
 		return fmt.Errorf("creating scanner: %w", err)
 	}
@@ -243,6 +299,11 @@ func runQuotaScan(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("scanning sessions: %w", err)
 	}
 
+	// Refresh the cached scan used by `gt quota assert`. Best-effort.
+	if err := quota.NewManager(townRoot).SaveScanCache(results, time.Now()); err != nil {
+		style.PrintWarning("could not cache scan results: %v", err)
+	}
+
 	// Optionally update quota state
 	if scanUpdate && loadErr == nil && acctCfg != nil {
 		if err := updateQuotaState(townRoot, results, acctCfg); err != nil {
@@ -250,12 +311,129 @@ func runQuotaScan(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	results = filterScanResults(results, scanBlockedOnly, scanNearLimitOnly)
+	if scanSummary {
+		summaries := summarizeScanByAccount(results)
+		if quotaJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(summaries)
+		}
+		printScanSummary(os.Stdout, summaries)
+		return nil
+	}
 	if quotaJSON {
 		return printScanJSON(results)
 	}
 	return printScanText(results)
 }
 
+// filterScanResults keeps rate-limited sessions when blockedOnly is set and
+// near-limit sessions when nearLimitOnly is set. Failed scans never match,
+// since their detection fields are not meaningful. With neither filter,
+// results are returned unchanged.
+func filterScanResults(results []quota.ScanResult, blockedOnly, nearLimitOnly bool) []quota.ScanResult {
+	if !blockedOnly && !nearLimitOnly {
+		return results
+	}
+	var out []quota.ScanResult
+	for _, r := range results {
+		if r.Error != "" {
+			continue
+		}
+		if (blockedOnly && r.RateLimited) || (nearLimitOnly && r.NearLimit) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// ScanAccountSummary is one line of gt quota scan --summary: the worst
+// status across all sessions using an account.
+type ScanAccountSummary struct {
+	Account   string `json:"account"`             // handle, or "(unknown)"
+	Status    string `json:"status"`              // limited, near-limit, error or ok
+	Sessions  int    `json:"sessions"`            // sessions using the account
+	Limited   int    `json:"limited"`             // of which rate-limited
+	NearLimit int    `json:"near_limit"`          // of which near the limit
+	Errors    int    `json:"errors"`              // of which could not be scanned
+	ResetsAt  string `json:"resets_at,omitempty"` // from a rate-limited session
+}
+
+// summarizeScanByAccount groups results by account, sorted by handle. A
+// result with Error set counts toward Errors only, since its detection
+// fields are not meaningful. An account with failed scans is never reported
+// as ok: its status is "error" unless another session shows it limited or
+// near the limit.
+func summarizeScanByAccount(results []quota.ScanResult) []ScanAccountSummary {
+	byAccount := make(map[string]*ScanAccountSummary)
+	for _, r := range results {
+		handle := r.AccountHandle
+		if handle == "" {
+			handle = "(unknown)"
+		}
+		sum := byAccount[handle]
+		if sum == nil {
+			sum = &ScanAccountSummary{Account: handle}
+			byAccount[handle] = sum
+		}
+		sum.Sessions++
+		switch {
+		case r.Error != "":
+			sum.Errors++
+		case r.RateLimited:
+			sum.Limited++
+			if sum.ResetsAt == "" {
+				sum.ResetsAt = r.ResetsAt
+			}
+		case r.NearLimit:
+			sum.NearLimit++
+		}
+	}
+
+	summaries := make([]ScanAccountSummary, 0, len(byAccount))
+	for _, handle := range slices.Sorted(maps.Keys(byAccount)) {
+		sum := byAccount[handle]
+		switch {
+		case sum.Limited > 0:
+			sum.Status = "limited"
+		case sum.NearLimit > 0:
+			sum.Status = "near-limit"
+		case sum.Errors > 0:
+			sum.Status = "error"
+		default:
+			sum.Status = "ok"
+		}
+		summaries = append(summaries, *sum)
+	}
+	return summaries
+}
+
+// printScanSummary prints one line per account.
+func printScanSummary(out io.Writer, summaries []ScanAccountSummary) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, sum := range summaries {
+		icon := style.Success.Render("✓")
+		switch sum.Status {
+		case "limited":
+			icon = style.Error.Render("!")
+		case "near-limit":
+			icon = style.Warning.Render("~")
+		case "error":
+			icon = style.Error.Render("✗")
+		}
+		detail := fmt.Sprintf("%d session(s)", sum.Sessions)
+		if sum.Errors > 0 {
+			detail += fmt.Sprintf(", %d scan error(s)", sum.Errors)
+		}
+		if sum.ResetsAt != "" {
+			detail += ", resets " + sum.ResetsAt
+		}
+		fmt.Fprintf(w, " %s %s\t%s\t%s\n", icon, sum.Account, sum.Status, detail)
+	}
+	_ = w.Flush()
+}
+
 func updateQuotaState(townRoot string, results []quota.ScanResult, acctCfg *config.AccountsConfig) error {
 	mgr := quota.NewManager(townRoot)
 	return mgr.WithLock(func() error {
@@ -330,6 +508,13 @@ func printScanText(results []quota.ScanResult) error {
 		}
 	}
 
+	var mismatches []quota.ScanResult
+	for _, r := range results {
+		if r.IdentityMismatch != nil {
+			mismatches = append(mismatches, r)
+		}
+	}
+
 	if limited == 0 && nearLimit == 0 {
 		fmt.Printf(" %s No rate-limited sessions detected (%d scanned)\n",
 			style.SuccessPrefix, len(results))
@@ -346,18 +531,39 @@ func printScanText(results []quota.ScanResult) error {
 			style.Warning.Render("Summary:"), strings.Join(parts, ", "), len(results))
 	}
 
+	for _, r := range results {
+		if r.Error != "" {
+			fmt.Printf(" %s %-25s %s\n", style.ErrorPrefix, r.Session, r.Error)
+		}
+	}
+
+	if len(mismatches) > 0 {
+		fmt.Println()
+		fmt.Printf(" %s %d session(s) use credentials from a different org than configured (excluded from rotation):\n",
+			style.Warning.Render("Identity mismatch:"), len(mismatches))
+		for _, r := range mismatches {
+			fmt.Printf("   %-25s %s %s (%s)\n",
+				r.Session,
+				style.Dim.Render("account:"),
+				r.AccountHandle,
+				r.IdentityMismatch)
+		}
+	}
+
 	return nil
 }
 
 // Rotate command flags
 var (
-	rotateDryRun bool
-	rotateFrom   string
-	rotateIdle   bool
+	rotateDryRun  bool
+	rotateFrom    string
+	rotateIdle    bool
+	rotateSession string
+	rotateForce   bool
 )
 
 var quotaRotateCmd = &cobra.Command{
-	Use:   "rotate",
+	Use:   "rotate [account-handle]",
 	Short: "Swap blocked sessions to available accounts",
 	Long: `Rotate rate-limited sessions to available accounts.
 
@@ -368,6 +574,11 @@ Use --from to preemptively rotate sessions using a specific account before
 it hits its rate limit. This is useful for switching idle sessions while
 it's not disruptive.
 
+Pass an account handle to rotate to that account instead of letting the
+planner choose. Combine it with --session to rotate one specific session,
+whether or not it is rate-limited. A target account that is rate-limited or
+whose credentials belong to a different org is refused unless --force is given.
+
 The rotation process:
   1. Scans all Gas Town sessions for rate-limit indicators
   2. Selects available accounts (LRU order)
@@ -380,7 +591,10 @@ Examples:
   gt quota rotate --from work        # Preemptively rotate sessions on 'work' account
   gt quota rotate --from work --idle # Only rotate idle sessions on 'work' account
   gt quota rotate --dry-run          # Show plan without executing
-  gt quota rotate --json             # JSON output`,
+  gt quota rotate --json             # JSON output
+  gt quota rotate personal           # Rotate blocked sessions to 'personal'
+  gt quota rotate personal --session gt-crew-bear`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runQuotaRotate,
 }
 
@@ -408,9 +622,16 @@ func runQuotaRotate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if len(args) == 1 {
+		return runQuotaRotateTo(townRoot, acctCfg, args[0])
+	}
+	if rotateSession != "" {
+		return fmt.Errorf("--session requires an account handle: gt quota rotate <account-handle> --session %s", rotateSession)
+	}
+
 	// Create scanner and plan rotation
 	t := ttmux.NewTmux()
-	scanner, err := quota.NewScanner(t, nil, acctCfg)
+	scanner, err := newQuotaScanner(townRoot, t, acctCfg)
 	if err != nil {
 		return fmt.Errorf("creating scanner: %w", err)
 	}
@@ -590,6 +811,201 @@ func runQuotaRotate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// quotaRotateExecutor rotates one session to an account.
+type quotaRotateExecutor func(session, account string) quota.RotateResult
+
+// rotateSessionsTo rotates each session to account, in order.
+func rotateSessionsTo(exec quotaRotateExecutor, sessions []string, account string) []quota.RotateResult {
+	results := make([]quota.RotateResult, 0, len(sessions))
+	for _, session := range sessions {
+		results = append(results, exec(session, account))
+	}
+	return results
+}
+
+// runQuotaRotateTo handles "gt quota rotate <account-handle>": it rotates
+// --session, or every rate-limited session (narrowed by --from), to handle.
+func runQuotaRotateTo(townRoot string, acctCfg *config.AccountsConfig, handle string) error {
+	acct, ok := acctCfg.Accounts[handle]
+	if !ok {
+		return fmt.Errorf("account %q not found (available: %s)",
+			handle, strings.Join(accountHandles(acctCfg), ", "))
+	}
+
+	t := ttmux.NewTmux()
+	mgr := quota.NewManager(townRoot)
+
+	if !rotateForce {
+		state, err := mgr.Load()
+		if err != nil {
+			return fmt.Errorf("loading quota state: %w", err)
+		}
+		mgr.ClearExpired(state)
+		if err := checkRotateTarget(state, acct, handle); err != nil {
+			return fmt.Errorf("%w (use --force to rotate anyway)", err)
+		}
+	}
+
+	var sessions []string
+	if rotateSession != "" {
+		// Rotating a missing session would fall back to ~/.claude and swap
+		// the wrong keychain entry, so insist that it exists.
+		exists, err := t.HasSession(rotateSession)
+		if err != nil {
+			return fmt.Errorf("checking session %s: %w", rotateSession, err)
+		}
+		if !exists {
+			return fmt.Errorf("session %q not found", rotateSession)
+		}
+		sessions = []string{rotateSession}
+	} else {
+		scanner, err := newQuotaScanner(townRoot, t, acctCfg)
+		if err != nil {
+			return fmt.Errorf("creating scanner: %w", err)
+		}
+		plan, err := quota.PlanRotation(scanner, mgr, acctCfg, quota.PlanOpts{FromAccount: rotateFrom})
+		if err != nil {
+			return fmt.Errorf("planning rotation: %w", err)
+		}
+		// The planner also catches mismatches reported by the scan itself
+		// and tokens that fail validation.
+		if reason, skipped := plan.SkippedAccounts[handle]; skipped && !rotateForce {
+			return fmt.Errorf("account %q is not a valid rotation target: %s (use --force to rotate anyway)", handle, reason)
+		}
+		var noConfigDir, onTarget int
+		sessions, noConfigDir, onTarget = selectRotateToSessions(plan.LimitedSessions, handle)
+		if !quotaJSON {
+			if noConfigDir > 0 {
+				fmt.Printf(" %s %d session(s) skipped (no CLAUDE_CONFIG_DIR)\n", style.WarningPrefix, noConfigDir)
+			}
+			if onTarget > 0 {
+				fmt.Printf(" %s %d session(s) skipped (already on %s)\n", style.WarningPrefix, onTarget, handle)
+			}
+		}
+	}
+
+	// Filter to idle sessions only when --idle is set, as for planned rotation.
+	if rotateIdle {
+		var busy []string
+		sessions, busy = partitionIdleSessions(sessions, t.IsIdle)
+		if !quotaJSON {
+			for _, session := range busy {
+				fmt.Printf(" %s %-25s %s\n", style.Dim.Render("-"), session, style.Dim.Render("skipped (busy)"))
+			}
+		}
+	}
+
+	if len(sessions) == 0 {
+		if quotaJSON {
+			return json.NewEncoder(os.Stdout).Encode([]quota.RotateResult{})
+		}
+		if rotateIdle {
+			fmt.Printf(" %s No idle sessions to rotate\n", style.WarningPrefix)
+		} else {
+			fmt.Printf(" %s No rate-limited sessions detected\n", style.SuccessPrefix)
+		}
+		return nil
+	}
+
+	if rotateDryRun {
+		if quotaJSON {
+			planned := make([]quota.RotateResult, 0, len(sessions))
+			for _, session := range sessions {
+				planned = append(planned, quota.RotateResult{Session: session, NewAccount: handle})
+			}
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(planned)
+		}
+		fmt.Println(style.Bold.Render("Rotation Plan"))
+		fmt.Println()
+		for _, session := range sessions {
+			fmt.Printf(" %s %-25s → %s\n", style.ArrowPrefix, session, style.Success.Render(handle))
+		}
+		fmt.Println()
+		fmt.Println(style.Dim.Render(" (dry run — no changes made)"))
+		return nil
+	}
+
+	swappedConfigDirs := make(map[string]*quota.KeychainCredential)
+	results := rotateSessionsTo(func(session, account string) quota.RotateResult {
+		return executeKeychainRotation(t, mgr, acctCfg, session, account, swappedConfigDirs)
+	}, sessions, handle)
+
+	if quotaJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(results)
+	}
+
+	for _, result := range results {
+		oldAccount := result.OldAccount
+		if oldAccount == "" {
+			oldAccount = "(unknown)"
+		}
+		if !result.Rotated {
+			fmt.Printf(" %s %s: %s\n", style.ErrorPrefix, result.Session, result.Error)
+			continue
+		}
+		fmt.Printf(" %s %s: %s → %s\n", style.SuccessPrefix, result.Session,
+			style.Dim.Render(oldAccount), style.Success.Render(result.NewAccount))
+		// The config dir is kept so /resume works; the token behind it changed.
+		fmt.Printf("   CLAUDE_CONFIG_DIR before: %s\n", result.ConfigDir)
+		fmt.Printf("   CLAUDE_CONFIG_DIR after:  %s (keychain now holds %s)\n", result.ConfigDir, result.NewAccount)
+		if result.Error != "" {
+			fmt.Printf("   %s %s\n", style.WarningPrefix, result.Error)
+		}
+	}
+	return nil
+}
+
+// checkRotateTarget refuses a target account that is currently rate-limited
+// or whose cached credentials belong to a different org than configured.
+func checkRotateTarget(state *config.QuotaState, acct config.Account, handle string) error {
+	if st, ok := state.Accounts[handle]; ok && st.Status == config.QuotaStatusLimited {
+		if st.ResetsAt != "" {
+			return fmt.Errorf("account %q is rate-limited (resets %s)", handle, st.ResetsAt)
+		}
+		return fmt.Errorf("account %q is rate-limited", handle)
+	}
+	if m, err := quota.CheckAccountIdentity(acct, util.ExpandHome(acct.ConfigDir)); err == nil && m != nil {
+		return fmt.Errorf("account %q has an identity mismatch: %s", handle, m)
+	}
+	return nil
+}
+
+// selectRotateToSessions picks the limited sessions to rotate to handle,
+// sorted. Sessions with neither an account nor a config dir are skipped,
+// since rotating them would fall back to ~/.claude and swap the wrong
+// keychain entry, as are sessions already on handle.
+func selectRotateToSessions(limited []quota.ScanResult, handle string) (sessions []string, noConfigDir, onTarget int) {
+	for _, r := range limited {
+		switch {
+		case r.AccountHandle == "" && r.ConfigDir == "":
+			noConfigDir++
+		case r.AccountHandle == handle:
+			onTarget++
+		default:
+			sessions = append(sessions, r.Session)
+		}
+	}
+	slices.Sort(sessions)
+	return sessions, noConfigDir, onTarget
+}
+
+// partitionIdleSessions splits sessions into those at the idle prompt and
+// those still busy, preserving order.
+func partitionIdleSessions(sessions []string, isIdle func(session string) bool) (idle, busy []string) {
+	for _, session := range sessions {
+		if isIdle(session) {
+			idle = append(idle, session)
+		} else {
+			busy = append(busy, session)
+		}
+	}
+	return idle, busy
+}
+
 var quotaClearCmd = &cobra.Command{
 	Use:   "clear [handle...]",
 	Short: "Mark account(s) as available again",
@@ -682,6 +1098,7 @@ func executeKeychainRotation(
 		}
 		currentConfigDir = home + "/.claude"
 	}
+	result.ConfigDir = currentConfigDir
 
 	// Resolve old account handle
 	for handle, acct := range acctCfg.Accounts {
@@ -804,6 +1221,7 @@ func executeKeychainRotation(
 var (
 	watchInterval time.Duration
 	watchDryRun   bool
+	watchJSON     bool
 )
 
 var quotaWatchCmd = &cobra.Command{
@@ -817,13 +1235,28 @@ hard rate limits and near-limit warning signals via pane pattern matching.
 When a session is detected as approaching its limit, rotation is triggered
 before the hard 429 hits.
 
+With --json, each poll prints one JSON object on its own line (JSONL)
+describing the limited and near-limit sessions and any rotations.
+
 Examples:
   gt quota watch                      # Watch with default 5m interval
   gt quota watch --interval 2m        # Custom interval
-  gt quota watch --dry-run            # Show detections without rotating`,
+  gt quota watch --dry-run            # Show detections without rotating
+  gt quota watch --json               # One JSON object per poll`,
 	RunE: runQuotaWatch,
 }
 
+// QuotaWatchCycle is the result of one gt quota watch poll.
+// With --json it is printed as a single line per poll.
+type QuotaWatchCycle struct {
+	Time      time.Time            `json:"time"`
+	Synced    int                  `json:"synced,omitempty"` // swapped keychains refreshed from their source
+	Limited   []quota.ScanResult   `json:"limited,omitempty"`
+	NearLimit []quota.ScanResult   `json:"near_limit,omitempty"`
+	Rotations []quota.RotateResult `json:"rotations,omitempty"`
+	Error     string               `json:"error,omitempty"`
+}
+
 func runQuotaWatch(cmd *cobra.Command, args []string) error {
 	townRoot, err := workspace.FindFromCwd()
 	if err != nil {
@@ -839,12 +1272,14 @@ func runQuotaWatch(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("need at least 2 accounts for rotation (have %d)", len(acctCfg.Accounts))
 	}
 
-	fmt.Printf(" %s Watching for near-limit signals (interval: %s)\n",
-		style.Info.Render("Watch:"), watchInterval)
-	if watchDryRun {
-		fmt.Println(style.Dim.Render(" (dry run — detections only, no rotation)"))
+	if !watchJSON {
+		fmt.Printf(" %s Watching for near-limit signals (interval: %s)\n",
+			style.Info.Render("Watch:"), watchInterval)
+		if watchDryRun {
+			fmt.Println(style.Dim.Render(" (dry run — detections only, no rotation)"))
+		}
+		fmt.Println()
 	}
-	fmt.Println()
 
 	// Handle graceful shutdown on SIGTERM/SIGINT
 	sigCh := make(chan os.Signal, 1)
@@ -853,31 +1288,49 @@ func runQuotaWatch(cmd *cobra.Command, args []string) error {
 	ticker := time.NewTicker(watchInterval)
 	defer ticker.Stop()
 
-	// Run immediately on start, then on each tick
+	enc := json.NewEncoder(os.Stdout)
+	watchLoop(ticker.C, sigCh, func() {
+		c := runWatchCycle(townRoot, acctCfg)
+		if watchJSON {
+			_ = enc.Encode(c)
+			return
+		}
+		printWatchCycle(c)
+	})
+
+	if !watchJSON {
+		fmt.Printf("\n %s Shutting down watch\n", style.Info.Render("Watch:"))
+	}
+	return nil
+}
+
+// watchLoop runs cycle immediately and then once per tick until stop fires.
+func watchLoop(ticks <-chan time.Time, stop <-chan os.Signal, cycle func()) {
 	for {
-		runWatchCycle(townRoot, acctCfg)
+		cycle()
 
 		select {
-		case <-sigCh:
-			fmt.Printf("\n %s Shutting down watch\n", style.Info.Render("Watch:"))
-			return nil
-		case <-ticker.C:
+		case <-stop:
+			return
+		case <-ticks:
 		}
 	}
 }
 
-func runWatchCycle(townRoot string, acctCfg *config.AccountsConfig) {
+func runWatchCycle(townRoot string, acctCfg *config.AccountsConfig) QuotaWatchCycle {
+	c := QuotaWatchCycle{Time: time.Now()}
+
 	t := ttmux.NewTmux()
-	scanner, err := quota.NewScanner(t, nil, acctCfg)
+	scanner, err := newQuotaScanner(townRoot, t, acctCfg)
 	if err != nil {
-		style.PrintWarning("creating scanner: %v", err)
-		return
+		c.Error = fmt.Sprintf("creating scanner: %v", err)
+		return c
 	}
 
 	// Enable near-limit detection via pane patterns
 	if err := scanner.WithWarningPatterns(nil); err != nil {
-		style.PrintWarning("setting warning patterns: %v", err)
-		return
+		c.Error = fmt.Sprintf("setting warning patterns: %v", err)
+		return c
 	}
 
 	mgr := quota.NewManager(townRoot)
@@ -886,37 +1339,58 @@ func runWatchCycle(townRoot string, acctCfg *config.AccountsConfig) {
 	// last rotation, propagate the fresh token to all target keychain entries.
 	if state, err := mgr.Load(); err == nil && len(state.ActiveSwaps) > 0 {
 		resolved := quota.ResolveSwapSourceDirs(state.ActiveSwaps, acctCfg.Accounts)
-		if n := quota.SyncSwappedTokens(resolved); n > 0 {
-			now := time.Now().Format("15:04:05")
-			fmt.Printf(" [%s] %s synced %d swapped keychain(s)\n",
-				style.Dim.Render(now),
-				style.Info.Render("Sync:"),
-				n)
-		}
+		c.Synced = quota.SyncSwappedTokens(resolved)
 	}
 
 	plan, err := quota.PlanRotation(scanner, mgr, acctCfg, quota.PlanOpts{IncludeNearLimit: true})
 	if err != nil {
-		style.PrintWarning("planning rotation: %v", err)
+		c.Error = fmt.Sprintf("planning rotation: %v", err)
+		return c
+	}
+	c.Limited = plan.LimitedSessions
+	c.NearLimit = plan.NearLimitSessions
+
+	if watchDryRun || len(c.Limited)+len(c.NearLimit) == 0 || len(plan.Assignments) == 0 {
+		return c
+	}
+
+	// Execute rotation
+	swappedConfigDirs := make(map[string]*quota.KeychainCredential)
+	for _, session := range slices.Sorted(maps.Keys(plan.Assignments)) {
+		newAccount := plan.Assignments[session]
+		c.Rotations = append(c.Rotations, executeKeychainRotation(t, mgr, acctCfg, session, newAccount, swappedConfigDirs))
+	}
+	return c
+}
+
+// printWatchCycle reports a watch poll in human-readable form.
+func printWatchCycle(c QuotaWatchCycle) {
+	now := c.Time.Format("15:04:05")
+	if c.Synced > 0 {
+		fmt.Printf(" [%s] %s synced %d swapped keychain(s)\n",
+			style.Dim.Render(now),
+			style.Info.Render("Sync:"),
+			c.Synced)
+	}
+	if c.Error != "" {
+		style.PrintWarning("%s", c.Error)
 		return
 	}
 
 	// Report findings
-	now := time.Now().Format("15:04:05")
-	totalTargets := len(plan.LimitedSessions) + len(plan.NearLimitSessions)
-	if totalTargets == 0 {
+	if len(c.Limited)+len(c.NearLimit) == 0 {
 		fmt.Printf(" [%s] %s\n", style.Dim.Render(now), style.Dim.Render("all clear"))
 		return
 	}
 
-	for _, r := range plan.LimitedSessions {
+	for _, r := range c.Limited {
 		fmt.Printf(" [%s] %s %-25s %s\n",
 			style.Dim.Render(now),
 			style.Error.Render("LIMITED"),
 			r.Session,
 			style.Dim.Render(r.AccountHandle))
 	}
-	for _, r := range plan.NearLimitSessions {
+	for _, r := range c.NearLimit {
 		detail := ""
 		if r.MatchedLine != "" {
 			detail = fmt.Sprintf(" (%s)", r.MatchedLine)
@@ -929,15 +1403,7 @@ func runWatchCycle(townRoot string, acctCfg *config.AccountsConfig) {
 			style.Dim.Render(detail))
 	}
 
-	if watchDryRun || len(plan.Assignments) == 0 {
-		return
-	}
-
-	// Execute rotation
-	swappedConfigDirs := make(map[string]*quota.KeychainCredential)
-	for _, session := range slices.Sorted(maps.Keys(plan.Assignments)) {
-		newAccount := plan.Assignments[session]
-		result := executeKeychainRotation(t, mgr, acctCfg, session, newAccount, swappedConfigDirs)
+	for _, result := range c.Rotations {
 		if result.Rotated {
 			fmt.Printf(" [%s] %s %s → %s\n",
 				style.Dim.Render(now),
@@ -954,19 +1420,246 @@ func runWatchCycle(townRoot string, acctCfg *config.AccountsConfig) {
 	}
 }
 
+// Assert command flags
+var (
+	assertSession        string
+	assertAccount        string
+	assertAllowNearLimit bool
+	assertMaxAge         time.Duration
+)
+
+// Exit codes for gt quota assert.
+const (
+	assertExitHardLimit = 5
+	assertExitOther     = 6
+)
+
+var quotaAssertCmd = &cobra.Command{
+	Use:   "assert",
+	Short: "Exit 0 if a session or account is safe to load with work",
+	Long: `Check whether a session (or account) can take new work, for use in
+pre-dispatch hooks.
+
+Answers from the cached scan written by 'gt quota scan', 'gt quota rotate'
+and the quota dog. When the cache is stale, only the requested session is
+scanned live. Accounts marked limited in quota state fail until their reset
+time passes.
+
+Exit codes:
+  0  safe to load
+  5  hard rate-limited
+  6  other failure (near limit, identity mismatch, session not found, ...)
+
+On failure a one-line reason is written to stderr.
+
+Examples:
+  gt quota assert --session gt-crew-bear
+  gt quota assert --account work --allow-near-limit`,
+	Args: cobra.NoArgs,
+	RunE: runQuotaAssert,
+}
+
+func runQuotaAssert(cmd *cobra.Command, args []string) error {
+	if (assertSession == "") == (assertAccount == "") {
+		return fmt.Errorf("specify exactly one of --session or --account")
+	}
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	newScanner := func() (*quota.Scanner, error) {
+		acctCfg, _ := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+		return newQuotaScanner(townRoot, ttmux.NewTmux(), acctCfg)
+	}
+	result, _, err := quota.ResolveAssertResult(quota.NewManager(townRoot), newScanner, quota.AssertQuery{
+		Session: assertSession,
+		Account: assertAccount,
+		MaxAge:  assertMaxAge,
+		Now:     time.Now(),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "quota assert: %v\n", err)
+		return NewSilentExit(assertExitOther)
+	}
+
+	ok, reason := quota.AssertQuota(result, quota.AssertOpts{AllowNearLimit: assertAllowNearLimit})
+	if ok {
+		return nil
+	}
+	fmt.Fprintf(os.Stderr, "quota assert: %s\n", reason)
+	if result.RateLimited {
+		return NewSilentExit(assertExitHardLimit)
+	}
+	return NewSilentExit(assertExitOther)
+}
+
+// Runtime GC flags
+var runtimeGCDryRun bool
+
+var quotaRuntimeCmd = &cobra.Command{
+	Use:   "runtime",
+	Short: "Maintain quota runtime state files",
+	RunE:  requireSubcommand,
+}
+
+var quotaRuntimeGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Validate, prune, and quarantine quota state files",
+	Long: `Run hygiene over every registered quota state file.
+
+Unreadable files (corrupt JSON, incompatible schema) and files over their
+size cap are renamed to <file>.corrupt-<timestamp> so the owner starts
+fresh instead of failing on every load. Expired entries (e.g. limits whose
+reset time has passed) are pruned.
+
+The daemon runs this at startup and daily when quota_dog is enabled.
+
+Examples:
+  gt quota runtime gc             # Apply
+  gt quota runtime gc --dry-run   # Show what would change
+  gt quota runtime gc --json`,
+	RunE: runQuotaRuntimeGC,
+}
+
+func runQuotaRuntimeGC(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	gc := quota.RuntimeGC
+	if runtimeGCDryRun {
+		gc = quota.PlanRuntimeGC
+	}
+	report, err := gc(townRoot, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if quotaJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+
+	verb := ""
+	if report.DryRun {
+		verb = "would be "
+	}
+	for _, f := range report.Files {
+		switch {
+		case f.Error != "":
+			fmt.Printf(" %s %s: %s\n", style.ErrorPrefix, f.Name, f.Error)
+		case f.Quarantined != "":
+			fmt.Printf(" %s %s %squarantined to %s (%s)\n", style.WarningPrefix, f.Name, verb, f.Quarantined, f.Reason)
+		case f.Pruned > 0:
+			fmt.Printf(" %s %s: %d expired entr(ies) %spruned\n", style.SuccessPrefix, f.Name, f.Pruned, verb)
+		case f.Missing:
+			fmt.Printf(" %s %s: not present\n", style.Dim.Render("-"), f.Name)
+		default:
+			fmt.Printf(" %s %s: ok\n", style.SuccessPrefix, f.Name)
+		}
+	}
+	return nil
+}
+
+// Org ID extract flags
+var (
+	orgIDConfigDir   string
+	orgIDAllAccounts bool
+)
+
+var quotaOrgIDExtractCmd = &cobra.Command{
+	Use:   "org-id-extract",
+	Short: "Print the organization UUID a config dir is logged into",
+	Long: `Read the organization UUID cached in <config-dir>/.claude.json
+(oauthAccount.organizationUuid) and print it.
+
+Use the result as the org_id of an account in mayor/accounts.json so quota
+rotation can detect config dirs logged into the wrong account.
+
+With --all-accounts, prints a table of every registered account and the
+org its config dir is logged into.
+
+Examples:
+  gt quota org-id-extract                          # ~/.claude
+  gt quota org-id-extract --config-dir ~/.claude-work
+  gt quota org-id-extract --all-accounts`,
+	Args: cobra.NoArgs,
+	RunE: runQuotaOrgIDExtract,
+}
+
+func runQuotaOrgIDExtract(cmd *cobra.Command, args []string) error {
+	if orgIDAllAccounts {
+		townRoot, err := workspace.FindFromCwdOrError()
+		if err != nil {
+			return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		}
+		acctCfg, err := config.LoadAccountsConfig(constants.MayorAccountsPath(townRoot))
+		if err != nil {
+			return fmt.Errorf("loading accounts: %w", err)
+		}
+		printAccountOrgIDs(os.Stdout, acctCfg)
+		return nil
+	}
+
+	orgID, err := extractOrgID(orgIDConfigDir)
+	if err != nil {
+		return err
+	}
+	fmt.Println(orgID)
+	return nil
+}
+
+// extractOrgID is quota.ReadOrgID with a missing UUID reported as an error.
+func extractOrgID(configDir string) (string, error) {
+	orgID, err := quota.ReadOrgID(configDir)
+	if err != nil {
+		return "", err
+	}
+	if orgID == "" {
+		return "", fmt.Errorf("no organization UUID in %s (not logged in, or .claude.json has no oauthAccount)",
+			filepath.Join(configDir, ".claude.json"))
+	}
+	return orgID, nil
+}
+
+// printAccountOrgIDs renders the --all-accounts table. Accounts whose org
+// can't be read show the reason instead of a UUID.
+func printAccountOrgIDs(out io.Writer, acctCfg *config.AccountsConfig) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "HANDLE	ORG ID	CONFIG DIR")
+	for _, handle := range accountHandles(acctCfg) {
+		acct := acctCfg.Accounts[handle]
+		orgID, err := extractOrgID(acct.ConfigDir)
+		if err != nil {
+			orgID = "error: " + err.Error()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", handle, orgID, acct.ConfigDir)
+	}
+	_ = w.Flush()
+}
+
 func init() {
 	quotaStatusCmd.Flags().BoolVar(&quotaJSON, "json", false, "Output as JSON")
 
 	quotaScanCmd.Flags().BoolVar(&quotaJSON, "json", false, "Output as JSON")
 	quotaScanCmd.Flags().BoolVar(&scanUpdate, "update", false, "Update quota state with detected limits")
+	quotaScanCmd.Flags().BoolVar(&scanBlockedOnly, "blocked-only", false, "Only show rate-limited sessions")
+	quotaScanCmd.Flags().BoolVar(&scanNearLimitOnly, "near-limit-only", false, "Only show near-limit sessions")
+	quotaScanCmd.Flags().BoolVar(&scanSummary, "summary", false, "Show one line per account with its worst status")
 
 	quotaRotateCmd.Flags().BoolVar(&rotateDryRun, "dry-run", false, "Show plan without executing")
 	quotaRotateCmd.Flags().BoolVar(&quotaJSON, "json", false, "Output as JSON")
 	quotaRotateCmd.Flags().StringVar(&rotateFrom, "from", "", "Preemptively rotate sessions using this account")
 	quotaRotateCmd.Flags().BoolVar(&rotateIdle, "idle", false, "Only rotate sessions at the idle prompt (skip busy agents)")
+	quotaRotateCmd.Flags().StringVar(&rotateSession, "session", "", "Rotate only this tmux session (requires an account handle)")
+	quotaRotateCmd.Flags().BoolVar(&rotateForce, "force", false, "Rotate to an account handle even if it is rate-limited or mismatched")
 
 	quotaWatchCmd.Flags().DurationVar(&watchInterval, "interval", 5*time.Minute, "Poll interval")
 	quotaWatchCmd.Flags().BoolVar(&watchDryRun, "dry-run", false, "Show detections without executing rotation")
+	quotaWatchCmd.Flags().BoolVar(&watchJSON, "json", false, "Print one JSON object per poll (JSONL)")
 
 	quotaCmd.AddCommand(quotaStatusCmd)
 	quotaCmd.AddCommand(quotaScanCmd)
@@ -974,5 +1667,20 @@ func init() {
 	quotaCmd.AddCommand(quotaClearCmd)
 	quotaCmd.AddCommand(quotaWatchCmd)
 
+	quotaAssertCmd.Flags().StringVar(&assertSession, "session", "", "tmux session to check")
+	quotaAssertCmd.Flags().StringVar(&assertAccount, "account", "", "Account handle to check")
+	quotaAssertCmd.Flags().BoolVar(&assertAllowNearLimit, "allow-near-limit", false, "Pass sessions showing near-limit warnings")
+	quotaAssertCmd.Flags().DurationVar(&assertMaxAge, "max-age", quota.DefaultScanCacheMaxAge, "Maximum age of the cached scan before scanning live")
+	quotaCmd.AddCommand(quotaAssertCmd)
+
+	quotaRuntimeGCCmd.Flags().BoolVar(&runtimeGCDryRun, "dry-run", false, "Show what would change without modifying files")
+	quotaRuntimeGCCmd.Flags().BoolVar(&quotaJSON, "json", false, "Output as JSON")
+	quotaRuntimeCmd.AddCommand(quotaRuntimeGCCmd)
+	quotaCmd.AddCommand(quotaRuntimeCmd)
+
+	quotaOrgIDExtractCmd.Flags().StringVar(&orgIDConfigDir, "config-dir", "~/.claude", "Claude config dir to read")
+	quotaOrgIDExtractCmd.Flags().BoolVar(&orgIDAllAccounts, "all-accounts", false, "List the org of every registered account")
+	quotaCmd.AddCommand(quotaOrgIDExtractCmd)
+
 	rootCmd.AddCommand(quotaCmd)
 }
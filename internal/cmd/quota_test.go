@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/quota"
+)
+
+func TestWatchLoop_OneJSONObjectPerTick(t *testing.T) {
+	ticks := make(chan time.Time)
+	stop := make(chan os.Signal)
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	n := 0
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		watchLoop(ticks, stop, func() {
+			n++
+			_ = enc.Encode(QuotaWatchCycle{
+				Time:    time.Date(2026, 1, 1, 0, 0, n, 0, time.UTC),
+				Limited: []quota.ScanResult{{Session: "gt-nux", RateLimited: true}},
+			})
+		})
+	}()
+
+	// The first cycle runs immediately; each tick adds exactly one more.
+	ticks <- time.Now()
+	ticks <- time.Now()
+	stop <- os.Interrupt
+	<-done
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d JSON lines, want 3 (initial + 2 ticks):\n%s", len(lines), buf.String())
+	}
+	for i, line := range lines {
+		var c QuotaWatchCycle
+		if err := json.Unmarshal([]byte(line), &c); err != nil {
+			t.Fatalf("line %d is not a JSON object: %v", i, err)
+		}
+		if c.Time.Second() != i+1 || len(c.Limited) != 1 {
+			t.Errorf("line %d = %+v", i, c)
+		}
+	}
+}
+
+func TestRotateSessionsTo_PassesAccountAndSession(t *testing.T) {
+	type call struct{ session, account string }
+	var calls []call
+	exec := func(session, account string) quota.RotateResult {
+		calls = append(calls, call{session, account})
+		return quota.RotateResult{Session: session, NewAccount: account, Rotated: true}
+	}
+
+	results := rotateSessionsTo(exec, []string{"gt-crew-bear"}, "personal")
+
+	if len(calls) != 1 || calls[0] != (call{"gt-crew-bear", "personal"}) {
+		t.Fatalf("executor calls = %+v, want one call for gt-crew-bear → personal", calls)
+	}
+	if len(results) != 1 || !results[0].Rotated || results[0].NewAccount != "personal" {
+		t.Errorf("results = %+v", results)
+	}
+}
+
+func TestDescribeReset(t *testing.T) {
+	in := func(d time.Duration) string {
+		return time.Now().Add(d).UTC().Format(time.RFC3339)
+	}
+	tests := []struct {
+		resetsAt string
+		want     string
+	}{
+		{in(2*time.Hour + 15*time.Minute + 20*time.Second), "resets in 2h15m"},
+		{in(45*time.Minute + 10*time.Second), "resets in 45m"},
+		{in(10 * time.Second), "resets in <1m"},
+		{"sometime soon", "resets sometime soon"},
+	}
+	for _, tt := range tests {
+		if got := describeReset(tt.resetsAt); got != tt.want {
+			t.Errorf("describeReset(%q) = %q, want %q", tt.resetsAt, got, tt.want)
+		}
+	}
+}
+
+func TestQuotaOrgIDExtract(t *testing.T) {
+	dir := t.TempDir()
+	doc := `{"oauthAccount":{"organizationUuid":"3f2a9c1e-0000-4000-8000-000000000001"}}`
+	if err := os.WriteFile(filepath.Join(dir, ".claude.json"), []byte(doc), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	oldDir, oldAll := orgIDConfigDir, orgIDAllAccounts
+	t.Cleanup(func() { orgIDConfigDir, orgIDAllAccounts = oldDir, oldAll })
+	orgIDConfigDir, orgIDAllAccounts = dir, false
+
+	var runErr error
+	out := captureStdout(t, func() { runErr = runQuotaOrgIDExtract(nil, nil) })
+	if runErr != nil {
+		t.Fatalf("runQuotaOrgIDExtract: %v", runErr)
+	}
+	if got := strings.TrimSpace(out); got != "3f2a9c1e-0000-4000-8000-000000000001" {
+		t.Errorf("output = %q, want the org UUID", got)
+	}
+
+	// A config dir without a cached identity is a clear error, not "".
+	orgIDConfigDir = t.TempDir()
+	if err := runQuotaOrgIDExtract(nil, nil); err == nil || !strings.Contains(err.Error(), "no organization UUID") {
+		t.Errorf("missing .claude.json: err = %v, want no organization UUID error", err)
+	}
+
+	var buf bytes.Buffer
+	printAccountOrgIDs(&buf, &config.AccountsConfig{Accounts: map[string]config.Account{
+		"work":     {ConfigDir: dir},
+		"personal": {ConfigDir: orgIDConfigDir},
+	}})
+	table := buf.String()
+	if !strings.Contains(table, "3f2a9c1e-0000-4000-8000-000000000001") {
+		t.Errorf("table missing work org ID:\n%s", table)
+	}
+	if !strings.Contains(table, "error: no organization UUID") {
+		t.Errorf("table missing personal error:\n%s", table)
+	}
+}
+
+func TestQuotaScanSummary(t *testing.T) {
+	results := []quota.ScanResult{
+		{Session: "gt-crew-a", AccountHandle: "work", RateLimited: true, ResetsAt: "7pm"},
+		{Session: "gt-crew-b", AccountHandle: "work"},
+		{Session: "gt-crew-c", AccountHandle: "work", NearLimit: true},
+		{Session: "gt-crew-d", AccountHandle: "personal", NearLimit: true},
+		{Session: "gt-crew-e", AccountHandle: "personal"},
+		{Session: "gt-crew-f", AccountHandle: "spare", Error: "analyzer panicked"},
+		{Session: "gt-crew-g", AccountHandle: "spare"},
+		{Session: "gt-crew-h", AccountHandle: "work", Error: "analyzer panicked", RateLimited: true},
+	}
+
+	summaries := summarizeScanByAccount(results)
+	var buf bytes.Buffer
+	printScanSummary(&buf, summaries)
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("summary has %d lines, want 3:\n%s", len(lines), buf.String())
+	}
+	if spare := summaries[1]; spare.Account != "spare" || spare.Status != "error" || spare.Errors != 1 {
+		t.Errorf("spare summary = %+v, want status error with 1 error", spare)
+	}
+	if !strings.Contains(lines[1], "1 scan error(s)") {
+		t.Errorf("line 2 = %q, want scan error count", lines[1])
+	}
+	if work := summaries[2]; work.Limited != 1 || work.Errors != 1 {
+		t.Errorf("work summary = %+v, want the failed scan counted as an error, not limited", work)
+	}
+	if !strings.Contains(lines[0], "personal") || !strings.Contains(lines[0], "near-limit") {
+		t.Errorf("line 1 = %q, want personal near-limit", lines[0])
+	}
+	if !strings.Contains(lines[2], "work") || !strings.Contains(lines[2], "limited") || !strings.Contains(lines[2], "4 session(s), 1 scan error(s), resets 7pm") {
+		t.Errorf("line 3 = %q, want work limited with 4 sessions", lines[2])
+	}
+
+	if got := filterScanResults(results, true, false); len(got) != 1 || got[0].Session != "gt-crew-a" {
+		t.Errorf("--blocked-only = %+v", got)
+	}
+	if got := filterScanResults(results, false, true); len(got) != 2 {
+		t.Errorf("--near-limit-only = %+v, want 2 sessions", got)
+	}
+	if got := filterScanResults(results, true, true); len(got) != 3 {
+		t.Errorf("both filters = %+v, want 3 sessions", got)
+	}
+}
+
+func TestRotateTo_SelectsAndChecksTarget(t *testing.T) {
+	limited := []quota.ScanResult{
+		{Session: "gt-crew-c", AccountHandle: "work"},
+		{Session: "gt-crew-a", ConfigDir: "/tmp/claude-a"},
+		{Session: "gt-crew-b"},                            // no account, no config dir
+		{Session: "gt-crew-d", AccountHandle: "personal"}, // already on the target
+	}
+	sessions, noConfigDir, onTarget := selectRotateToSessions(limited, "personal")
+	if want := []string{"gt-crew-a", "gt-crew-c"}; !slices.Equal(sessions, want) {
+		t.Errorf("sessions = %v, want %v", sessions, want)
+	}
+	if noConfigDir != 1 || onTarget != 1 {
+		t.Errorf("noConfigDir, onTarget = %d, %d, want 1, 1", noConfigDir, onTarget)
+	}
+
+	idle, busy := partitionIdleSessions(sessions, func(s string) bool { return s == "gt-crew-c" })
+	if !slices.Equal(idle, []string{"gt-crew-c"}) || !slices.Equal(busy, []string{"gt-crew-a"}) {
+		t.Errorf("idle, busy = %v, %v", idle, busy)
+	}
+
+	state := &config.QuotaState{Accounts: map[string]config.AccountQuotaState{
+		"personal": {Status: config.QuotaStatusLimited, ResetsAt: "7pm"},
+		"work":     {Status: config.QuotaStatusAvailable},
+	}}
+	if err := checkRotateTarget(state, config.Account{}, "personal"); err == nil || !strings.Contains(err.Error(), "rate-limited") {
+		t.Errorf("limited target: err = %v, want rate-limited error", err)
+	}
+
+	dir := t.TempDir()
+	doc := `{"oauthAccount":{"organizationUuid":"other-org"}}`
+	if err := os.WriteFile(filepath.Join(dir, ".claude.json"), []byte(doc), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkRotateTarget(state, config.Account{ConfigDir: dir, OrgID: "work-org"}, "work"); err == nil || !strings.Contains(err.Error(), "identity mismatch") {
+		t.Errorf("mismatched target: err = %v, want identity mismatch error", err)
+	}
+	if err := checkRotateTarget(state, config.Account{ConfigDir: dir, OrgID: "other-org"}, "work"); err != nil {
+		t.Errorf("valid target: err = %v", err)
+	}
+}
+
+// paneTmux is a quota.TmuxClient serving fixed pane content per session.
+type paneTmux map[string]string
+
+func (p paneTmux) ListSessions() ([]string, error) {
+	return slices.Sorted(maps.Keys(p)), nil
+}
+
+func (p paneTmux) CapturePane(session string, lines int) (string, error) {
+	return p[session], nil
+}
+
+func (p paneTmux) GetEnvironment(session, key string) (string, error) {
+	return "", os.ErrNotExist
+}
+
+func TestNewQuotaScanner_TownPatternFile(t *testing.T) {
+	townRoot := t.TempDir()
+	tmux := paneTmux{"gt-crew-bear": "Error: custom quota wall reached"}
+	acctCfg := &config.AccountsConfig{}
+
+	// Without a configured file the built-in patterns miss the custom text.
+	scanner, err := newQuotaScanner(townRoot, tmux, acctCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, err := scanner.ScanSession("gt-crew-bear"); err != nil || r.RateLimited {
+		t.Fatalf("built-in patterns: RateLimited = %v, err = %v; want false", r.RateLimited, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(townRoot, "ratelimit.txt"), []byte("# custom\ncustom quota wall\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	settings := config.NewTownSettings()
+	settings.Quota = &config.QuotaConfig{RateLimitPatternFile: "ratelimit.txt"}
+	if err := config.SaveTownSettings(config.TownSettingsPath(townRoot), settings); err != nil {
+		t.Fatal(err)
+	}
+
+	scanner, err = newQuotaScanner(townRoot, tmux, acctCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, err := scanner.ScanSession("gt-crew-bear"); err != nil || !r.RateLimited {
+		t.Errorf("town pattern file: RateLimited = %v, err = %v; want true", r.RateLimited, err)
+	}
+
+	// A configured file that cannot be loaded is an error, not a silent fallback.
+	settings.Quota.RateLimitPatternFile = "missing.txt"
+	if err := config.SaveTownSettings(config.TownSettingsPath(townRoot), settings); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newQuotaScanner(townRoot, tmux, acctCfg); err == nil {
+		t.Error("expected an error for a missing pattern file")
+	}
+}
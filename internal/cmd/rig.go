@@ -93,9 +93,14 @@ For each rig, displays:
   - Refinery status (running/stopped)
   - Number of polecats and crew members
 
+With --routes, lists every prefix in the town's routes.jsonl instead, with
+its resolved path, whether its .beads directory exists (DOLT) and how many
+tmux sessions use the prefix.
+
 Examples:
   gt rig list          # List all rigs with status
-  gt rig list --json   # Output as JSON for scripting`,
+  gt rig list --json   # Output as JSON for scripting
+  gt rig list --routes # Prefix routes with beads/session health`,
 	RunE: runRigList,
 }
 
@@ -319,6 +324,7 @@ var (
 	rigRestartForce    bool
 	rigRestartNuclear  bool
 	rigListJSON        bool
+	rigListRoutes      bool
 	rigRemoveForce     bool
 )
 
@@ -355,6 +361,7 @@ func init() {
 	rigCmd.AddCommand(rigStopCmd)
 
 	rigListCmd.Flags().BoolVar(&rigListJSON, "json", false, "Output as JSON")
+	rigListCmd.Flags().BoolVar(&rigListRoutes, "routes", false, "List routes.jsonl entries with beads and session health")
 
 	rigRemoveCmd.Flags().BoolVarP(&rigRemoveForce, "force", "f", false, "Kill running tmux sessions before removing (may lose uncommitted work)")
 
@@ -736,6 +743,10 @@ func runRigList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
+	if rigListRoutes {
+		return runRigListRoutes(townRoot)
+	}
+
 	// Load rigs config
 	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
 	rigsConfig, err := config.LoadRigsConfig(rigsPath)
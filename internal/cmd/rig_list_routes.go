@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// sessionLister is the subset of tmux.Tmux used by gt rig list --routes,
+// extracted to allow test injection.
+type sessionLister interface {
+	ListSessions() ([]string, error)
+}
+
+// rigRouteHealth is one row of gt rig list --routes: a routes.jsonl entry,
+// whether its beads database directory exists and how many tmux sessions
+// use its prefix.
+type rigRouteHealth struct {
+	Prefix   string `json:"prefix"`
+	Path     string `json:"path"`
+	Dolt     bool   `json:"dolt"`
+	Sessions int    `json:"sessions"`
+}
+
+// collectRigRouteHealth builds the --routes rows for townRoot, sorted by
+// prefix. A tmux server that isn't running counts as zero sessions.
+func collectRigRouteHealth(townRoot string, t sessionLister) ([]rigRouteHealth, error) {
+	routes, err := beads.LoadRoutes(beads.GetTownBeadsPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading routes: %w", err)
+	}
+	sessions, _ := t.ListSessions()
+
+	rows := make([]rigRouteHealth, 0, len(routes))
+	for _, route := range routes {
+		rigPath := townRoot
+		if route.Path != "." {
+			rigPath = filepath.Join(townRoot, route.Path)
+		}
+		row := rigRouteHealth{Prefix: route.Prefix, Path: rigPath}
+		if info, err := os.Stat(beads.ResolveBeadsDir(rigPath)); err == nil && info.IsDir() {
+			row.Dolt = true
+		}
+		for _, name := range sessions {
+			if strings.HasPrefix(name, route.Prefix) {
+				row.Sessions++
+			}
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Prefix < rows[j].Prefix })
+	return rows, nil
+}
+
+// printRigRouteHealth renders rows as the --routes table.
+func printRigRouteHealth(out io.Writer, rows []rigRouteHealth) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PREFIX\tPATH\tDOLT\tSESSIONS")
+	for _, row := range rows {
+		dolt := "missing"
+		if row.Dolt {
+			dolt = "ok"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", row.Prefix, row.Path, dolt, row.Sessions)
+	}
+	_ = w.Flush()
+}
+
+// runRigListRoutes implements gt rig list --routes.
+func runRigListRoutes(townRoot string) error {
+	rows, err := collectRigRouteHealth(townRoot, tmux.NewTmux())
+	if err != nil {
+		return err
+	}
+	if rigListJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	}
+	if len(rows) == 0 {
+		fmt.Println("No routes configured.")
+		return nil
+	}
+	printRigRouteHealth(os.Stdout, rows)
+	return nil
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+type fakeSessionLister []string
+
+func (f fakeSessionLister) ListSessions() ([]string, error) { return f, nil }
+
+func TestCollectRigRouteHealth(t *testing.T) {
+	townRoot := t.TempDir()
+	// gastown has a beads dir and running sessions; beads has neither.
+	if err := os.MkdirAll(filepath.Join(townRoot, "gastown", "mayor", "rig", ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	routes := []beads.Route{
+		{Prefix: "gt-", Path: "gastown/mayor/rig"},
+		{Prefix: "bd-", Path: "beads/mayor/rig"},
+	}
+	if err := beads.WriteRoutes(beads.GetTownBeadsPath(townRoot), routes); err != nil {
+		t.Fatal(err)
+	}
+
+	sessions := fakeSessionLister{"gt-witness", "gt-refinery", "gt-gastown-nux", "hq-mayor"}
+	rows, err := collectRigRouteHealth(townRoot, sessions)
+	if err != nil {
+		t.Fatalf("collectRigRouteHealth: %v", err)
+	}
+
+	want := []rigRouteHealth{
+		{Prefix: "bd-", Path: filepath.Join(townRoot, "beads/mayor/rig"), Dolt: false, Sessions: 0},
+		{Prefix: "gt-", Path: filepath.Join(townRoot, "gastown/mayor/rig"), Dolt: true, Sessions: 3},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("rows = %+v, want %+v", rows, want)
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Errorf("rows[%d] = %+v, want %+v", i, rows[i], want[i])
+		}
+	}
+
+	var buf bytes.Buffer
+	printRigRouteHealth(&buf, rows)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("table has %d lines, want 3:\n%s", len(lines), buf.String())
+	}
+	if fields := strings.Fields(lines[0]); strings.Join(fields, " ") != "PREFIX PATH DOLT SESSIONS" {
+		t.Errorf("header = %q", lines[0])
+	}
+	if fields := strings.Fields(lines[1]); fields[0] != "bd-" || fields[2] != "missing" || fields[3] != "0" {
+		t.Errorf("bd- row = %q", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); fields[0] != "gt-" || fields[2] != "ok" || fields[3] != "3" {
+		t.Errorf("gt- row = %q", lines[2])
+	}
+}
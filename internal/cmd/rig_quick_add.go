@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -165,15 +166,15 @@ func sanitizeRigName(name string) string {
 }
 
 func findOrCreateTown() (string, error) {
-	// Priority 1: GT_TOWN_ROOT env var (explicit user preference)
-	if townRoot := os.Getenv("GT_TOWN_ROOT"); townRoot != "" {
+	// Priority 1: GT_ROOT (or legacy GT_TOWN_ROOT) env var (explicit user preference)
+	if townRoot, _, _ := config.ResolveRootEnv(); townRoot != "" {
 		if isValidTown(townRoot) {
 			return townRoot, nil
 		}
 	}
 
 	// Priority 2: Try to find from cwd (supports multiple town installations)
-	if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
+	if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" && isValidTown(townRoot) {
 		return townRoot, nil
 	}
 
@@ -197,9 +198,10 @@ func findOrCreateTown() (string, error) {
 	return "", fmt.Errorf("no Gas Town found - run 'gt install ~/gt' first")
 }
 
-// isValidTown checks if a path is a valid Gas Town installation.
+// isValidTown checks if a path is a complete Gas Town installation: a
+// mayor/ directory alone (e.g. a rig-level mayor) is not enough, it must
+// also hold town.json.
 func isValidTown(path string) bool {
-	mayorDir := filepath.Join(path, "mayor")
-	_, err := os.Stat(mayorDir)
-	return err == nil
+	info, err := os.Stat(filepath.Join(path, workspace.PrimaryMarker))
+	return err == nil && !info.IsDir()
 }
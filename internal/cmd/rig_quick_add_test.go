@@ -6,6 +6,17 @@ import (
 	"testing"
 )
 
+// makeTestTown creates a minimal complete town (mayor/town.json) in dir.
+func makeTestTown(t *testing.T, dir string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(dir, "mayor"), 0755); err != nil {
+		t.Fatalf("mkdir mayor: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "mayor", "town.json"), []byte(`{"type":"town","name":"test"}`), 0644); err != nil {
+		t.Fatalf("write town.json: %v", err)
+	}
+}
+
 func TestFindOrCreateTown(t *testing.T) {
 	// Save original env and restore after test
 	origTownRoot := os.Getenv("GT_TOWN_ROOT")
@@ -14,10 +25,7 @@ func TestFindOrCreateTown(t *testing.T) {
 	t.Run("respects GT_TOWN_ROOT when set", func(t *testing.T) {
 		// Create a valid town in temp dir
 		tmpTown := t.TempDir()
-		mayorDir := filepath.Join(tmpTown, "mayor")
-		if err := os.MkdirAll(mayorDir, 0755); err != nil {
-			t.Fatalf("mkdir mayor: %v", err)
-		}
+		makeTestTown(t, tmpTown)
 
 		os.Setenv("GT_TOWN_ROOT", tmpTown)
 
@@ -41,11 +49,11 @@ func TestFindOrCreateTown(t *testing.T) {
 		}
 
 		gtPath := filepath.Join(home, "gt")
-		mayorDir := filepath.Join(gtPath, "mayor")
+		townJSON := filepath.Join(gtPath, "mayor", "town.json")
 
 		// Skip if ~/gt doesn't exist (don't want to create it in user's home)
-		if _, err := os.Stat(mayorDir); os.IsNotExist(err) {
-			t.Skip("~/gt/mayor does not exist, skipping fallback test")
+		if _, err := os.Stat(townJSON); os.IsNotExist(err) {
+			t.Skip("~/gt/mayor/town.json does not exist, skipping fallback test")
 		}
 
 		result, err := findOrCreateTown()
@@ -63,12 +71,8 @@ func TestFindOrCreateTown(t *testing.T) {
 		tmpTown1 := t.TempDir()
 		tmpTown2 := t.TempDir()
 
-		if err := os.MkdirAll(filepath.Join(tmpTown1, "mayor"), 0755); err != nil {
-			t.Fatalf("mkdir mayor1: %v", err)
-		}
-		if err := os.MkdirAll(filepath.Join(tmpTown2, "mayor"), 0755); err != nil {
-			t.Fatalf("mkdir mayor2: %v", err)
-		}
+		makeTestTown(t, tmpTown1)
+		makeTestTown(t, tmpTown2)
 
 		// Set GT_TOWN_ROOT to tmpTown1
 		os.Setenv("GT_TOWN_ROOT", tmpTown1)
@@ -85,18 +89,26 @@ func TestFindOrCreateTown(t *testing.T) {
 }
 
 func TestIsValidTown(t *testing.T) {
-	t.Run("valid town has mayor directory", func(t *testing.T) {
+	t.Run("valid town has mayor/town.json", func(t *testing.T) {
 		tmpDir := t.TempDir()
-		mayorDir := filepath.Join(tmpDir, "mayor")
-		if err := os.MkdirAll(mayorDir, 0755); err != nil {
-			t.Fatalf("mkdir: %v", err)
-		}
+		makeTestTown(t, tmpDir)
 
 		if !isValidTown(tmpDir) {
 			t.Error("isValidTown() = false, want true")
 		}
 	})
 
+	t.Run("mayor directory without town.json is invalid", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		if err := os.MkdirAll(filepath.Join(tmpDir, "mayor"), 0755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+
+		if isValidTown(tmpDir) {
+			t.Error("isValidTown() = true, want false")
+		}
+	})
+
 	t.Run("invalid town missing mayor directory", func(t *testing.T) {
 		tmpDir := t.TempDir()
 
@@ -76,6 +76,13 @@ var beadsExemptCommands = map[string]bool{
 	"heartbeat":           true, // Heartbeat state update — must be fast and dependency-free
 }
 
+// Subcommands exempt from the beads check, keyed by command path below the
+// root (see subcommandPath). Use this instead of beadsExemptCommands when the
+// leaf name is shared with unrelated commands, like "list" or "logs".
+var beadsExemptCommandPaths = map[string]bool{
+	"logs list": true, // Log inventory only stats files
+}
+
 // Commands exempt from the town root branch warning.
 // These are commands that help fix the problem or are diagnostic.
 var branchCheckExemptCommands = map[string]bool{
@@ -100,7 +107,7 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 	if BuiltProperly == "" && Build == "dev" {
 		fmt.Fprintln(os.Stderr, "WARNING: This binary was built with 'go build' directly.")
 		fmt.Fprintln(os.Stderr, "         Use 'make build' to create a properly signed binary.")
-		if gtRoot := os.Getenv("GT_ROOT"); gtRoot != "" {
+		if gtRoot, _, _ := config.ResolveRootEnv(); gtRoot != "" {
 			fmt.Fprintf(os.Stderr, "         Run from: %s\n", gtRoot)
 		}
 	}
@@ -112,7 +119,7 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 	logCommandUsage(cmd, args)
 
 	// Initialize session prefix registry and agent registry from town root.
-	// Try CWD detection first, then fall back to GT_TOWN_ROOT / GT_ROOT env vars.
+	// Try CWD detection first, then fall back to the GT_ROOT (or legacy GT_TOWN_ROOT) env var.
 	// Env var fallback ensures commands invoked from outside the town directory
 	// (e.g., "gt agents menu" via a cross-socket tmux binding) still connect to
 	// the correct town socket rather than silently using the wrong server.
@@ -126,7 +133,8 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 	cmdName := cmd.Name()
 
 	// Check for stale binary (warning only, doesn't block)
-	if !beadsExemptCommands[cmdName] {
+	beadsExempt := beadsExemptCommands[cmdName] || beadsExemptCommandPaths[subcommandPath(cmd)]
+	if !beadsExempt {
 		checkStaleBinaryWarning()
 	}
 
@@ -142,7 +150,7 @@ func persistentPreRun(cmd *cobra.Command, args []string) error {
 	touchPolecatHeartbeat()
 
 	// Skip beads check for exempt commands
-	if beadsExemptCommands[cmdName] || isRoleCommand(cmd) {
+	if beadsExempt || isRoleCommand(cmd) {
 		return nil
 	}
 
@@ -359,6 +367,16 @@ func buildCommandPath(cmd *cobra.Command) string {
 	return strings.Join(parts, " ")
 }
 
+// subcommandPath is buildCommandPath without the root command name, which
+// varies with GT_COMMAND. For example: "mail send", "logs list".
+func subcommandPath(cmd *cobra.Command) string {
+	var parts []string
+	for c := cmd; c != nil && c.HasParent(); c = c.Parent() {
+		parts = append([]string{c.Name()}, parts...)
+	}
+	return strings.Join(parts, " ")
+}
+
 // requireSubcommand returns a RunE function for parent commands that require
 // a subcommand. Without this, Cobra silently shows help and exits 0 for
 // unknown subcommands like "gt mol foobar", masking errors.
@@ -248,3 +248,16 @@ func TestPersistentPreRunMalformedAgentRegistry(t *testing.T) {
 		t.Fatalf("GetProcessNames(claude) after malformed registry = %v, want builtin [node claude ...]", got)
 	}
 }
+
+func TestLogsListBeadsExempt(t *testing.T) {
+	if got := subcommandPath(logsListCmd); got != "logs list" {
+		t.Errorf("subcommandPath(logsListCmd) = %q, want %q", got, "logs list")
+	}
+	if !beadsExemptCommandPaths[subcommandPath(logsListCmd)] {
+		t.Error("gt logs list should be beads-exempt")
+	}
+	// Exempting by path must not leak to other commands with a "logs" segment.
+	if beadsExemptCommands[daemonLogsCmd.Name()] || beadsExemptCommandPaths[subcommandPath(daemonLogsCmd)] {
+		t.Error("gt daemon logs should not be beads-exempt")
+	}
+}
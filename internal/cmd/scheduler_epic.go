@@ -306,10 +306,10 @@ func getEpicChildren(epicID string) ([]epicChild, error) {
 	// bd sql queries the correct database. resolveBeadDir returns the town root
 	// (for bd CLI routing), but bd sql doesn't use routes.jsonl.
 	sqlDir := dir
-	if prefix := beads.ExtractPrefix(epicID); prefix != "" {
+	if beads.ExtractPrefix(epicID) != "" {
 		townRoot, err := workspace.FindFromCwd()
 		if err == nil {
-			if rigPath := beads.GetRigPathForPrefix(townRoot, prefix); rigPath != "" {
+			if rigPath := beads.GetRigPathForID(townRoot, epicID); rigPath != "" {
 				sqlDir = rigPath
 			}
 		}
@@ -3,16 +3,19 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/quota"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
@@ -31,7 +34,11 @@ var (
 	sessionFile       string
 	sessionRigFilter  string
 	sessionListJSON   bool
+	sessionListHBs    bool
+	sessionListStale  bool
 	sessionStatusJSON bool
+	sessionCleanStale bool
+	sessionDryRun     bool
 )
 
 var sessionCmd = &cobra.Command{
@@ -39,12 +46,15 @@ var sessionCmd = &cobra.Command{
 	Aliases: []string{"sess"},
 	GroupID: GroupAgents,
 	Short:   "Manage polecat sessions",
-	RunE:    requireSubcommand,
+	RunE:    runSession,
 	Long: `Manage tmux sessions for polecats.
 
 Sessions are tmux sessions running Claude for each polecat.
 Use the subcommands to start, stop, attach, and monitor sessions.
 
+Use --cleanup-stale to remove session heartbeat files older than the
+polecat heartbeat stale threshold (add --dry-run to only list them).
+
 TIP: To send messages to a running session, use 'gt nudge' (not 'session inject').
 The nudge command uses reliable delivery that works correctly with Claude Code.`,
 }
@@ -91,7 +101,13 @@ var sessionListCmd = &cobra.Command{
 	Short: "List all sessions",
 	Long: `List all running polecat sessions.
 
-Shows session status, rig, and polecat name. Use --rig to filter by rig.`,
+Shows session status, rig, and polecat name. Use --rig to filter by rig.
+
+With --heartbeats, lists session heartbeat files instead, freshest first,
+marking each as active or stale against the polecat heartbeat threshold.
+Sessions no longer in tmux are shown as dead. The account and rate-limit
+state come from the latest quota scan, if it is recent. Use --stale to
+show only stale heartbeats.`,
 	RunE: runSessionList,
 }
 
@@ -178,6 +194,8 @@ func init() {
 	// List flags
 	sessionListCmd.Flags().StringVar(&sessionRigFilter, "rig", "", "Filter by rig name")
 	sessionListCmd.Flags().BoolVar(&sessionListJSON, "json", false, "Output as JSON")
+	sessionListCmd.Flags().BoolVar(&sessionListHBs, "heartbeats", false, "List session heartbeats with their age")
+	sessionListCmd.Flags().BoolVar(&sessionListStale, "stale", false, "List only stale session heartbeats (implies --heartbeats)")
 
 	// Capture flags
 	sessionCaptureCmd.Flags().IntVarP(&sessionLines, "lines", "n", 100, "Number of lines to capture")
@@ -193,6 +211,9 @@ func init() {
 	sessionStatusCmd.Flags().BoolVar(&sessionStatusJSON, "json", false, "Output as JSON")
 
 	// Add subcommands
+	sessionCmd.Flags().BoolVar(&sessionCleanStale, "cleanup-stale", false, "Remove stale session heartbeat files")
+	sessionCmd.Flags().BoolVar(&sessionDryRun, "dry-run", false, "With --cleanup-stale, list files without removing them")
+
 	sessionCmd.AddCommand(sessionStartCmd)
 	sessionCmd.AddCommand(sessionStopCmd)
 	sessionCmd.AddCommand(sessionAtCmd)
@@ -355,6 +376,10 @@ func runSessionList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
+	if sessionListHBs || sessionListStale {
+		return listSessionHeartbeats(townRoot)
+	}
+
 	// Load rigs config
 	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
 	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
@@ -427,6 +452,161 @@ func runSessionList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runSession(cmd *cobra.Command, args []string) error {
+	if !sessionCleanStale {
+		if sessionDryRun {
+			return fmt.Errorf("--dry-run requires --cleanup-stale")
+		}
+		return requireSubcommand(cmd, args)
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	threshold := polecat.LoadHeartbeatConfig(townRoot).StaleThreshold
+	removed, err := polecat.CleanupStaleHeartbeats(townRoot, threshold, sessionDryRun)
+	verb := "Removed"
+	if sessionDryRun {
+		verb = "Would remove"
+	}
+	for _, path := range removed {
+		fmt.Printf("  %s\n", style.Dim.Render(path))
+	}
+	fmt.Printf("%s %d stale heartbeat(s) (threshold %s)\n", verb, len(removed), threshold)
+	if err != nil {
+		return fmt.Errorf("cleaning stale heartbeats: %w", err)
+	}
+	return nil
+}
+
+// SessionHeartbeatItem is a heartbeat in `gt session list --heartbeats --json` output.
+type SessionHeartbeatItem struct {
+	polecat.HeartbeatEntry
+	Stale     bool   `json:"stale"`
+	Running   bool   `json:"running"`              // session exists in tmux
+	Account   string `json:"account,omitempty"`    // from the latest quota scan
+	RateLimit string `json:"rate_limit,omitempty"` // from the latest quota scan
+}
+
+// status summarizes the item for the STATUS column.
+func (it SessionHeartbeatItem) status() string {
+	switch {
+	case !it.Running:
+		return "dead"
+	case it.Stale:
+		return "stale"
+	default:
+		return "active"
+	}
+}
+
+// collectSessionHeartbeats returns active then stale heartbeats (only stale
+// ones with staleOnly), annotated with tmux presence from t and account and
+// rate-limit state from cache, which may be nil. The heartbeats directory is
+// read once, so every entry is judged against the same clock.
+func collectSessionHeartbeats(townRoot string, threshold time.Duration, t sessionLister, cache *quota.ScanCache, staleOnly bool) ([]SessionHeartbeatItem, error) {
+	entries, err := polecat.ListHeartbeats(townRoot)
+	if err != nil {
+		return nil, fmt.Errorf("listing heartbeats: %w", err)
+	}
+
+	running := make(map[string]bool)
+	if names, err := t.ListSessions(); err == nil {
+		for _, name := range names {
+			running[name] = true
+		}
+	}
+
+	items := make([]SessionHeartbeatItem, 0, len(entries))
+	for _, e := range entries { // freshest first, so active precede stale
+		isStale := e.Age >= threshold
+		if staleOnly && !isStale {
+			continue
+		}
+		it := SessionHeartbeatItem{HeartbeatEntry: e, Stale: isStale, Running: running[e.SessionID]}
+		if r, ok := cache.Lookup(e.SessionID); ok {
+			it.Account = r.AccountHandle
+			switch {
+			case r.RateLimited && r.ResetsAt != "":
+				it.RateLimit = "limited, " + describeReset(r.ResetsAt)
+			case r.RateLimited:
+				it.RateLimit = "limited"
+			case r.NearLimit:
+				it.RateLimit = "near limit"
+			default:
+				it.RateLimit = "ok"
+			}
+		}
+		items = append(items, it)
+	}
+	return items, nil
+}
+
+// listSessionHeartbeats prints active then stale session heartbeats.
+func listSessionHeartbeats(townRoot string) error {
+	threshold := polecat.LoadHeartbeatConfig(townRoot).StaleThreshold
+	cache, err := quota.NewManager(townRoot).LoadScanCache()
+	if err != nil || !cache.Fresh(time.Now(), quota.DefaultScanCacheMaxAge) {
+		cache = nil
+	}
+	items, err := collectSessionHeartbeats(townRoot, threshold, tmux.NewTmux(), cache, sessionListStale)
+	if err != nil {
+		return err
+	}
+
+	if sessionListJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(items)
+	}
+
+	if len(items) == 0 {
+		if sessionListStale {
+			fmt.Println("No stale session heartbeats.")
+		} else {
+			fmt.Println("No session heartbeats.")
+		}
+		return nil
+	}
+
+	if err := printSessionHeartbeats(os.Stdout, items); err != nil {
+		return err
+	}
+	fmt.Printf("\n%s\n", style.Dim.Render(heartbeatSummary(items, threshold)))
+	return nil
+}
+
+// heartbeatSummary counts items by STATUS column for the line under the
+// --heartbeats table. Sessions gone from tmux count as dead, not active.
+func heartbeatSummary(items []SessionHeartbeatItem, threshold time.Duration) string {
+	counts := make(map[string]int)
+	for _, it := range items {
+		counts[it.status()]++
+	}
+	return fmt.Sprintf("%d active, %d stale, %d dead (threshold %s)",
+		counts["active"], counts["stale"], counts["dead"], threshold)
+}
+
+// printSessionHeartbeats renders items as the --heartbeats table.
+func printSessionHeartbeats(out io.Writer, items []SessionHeartbeatItem) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SESSION\tACCOUNT\tSTATUS\tHEARTBEAT AGE\tRATE LIMIT")
+	for _, it := range items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", it.SessionID, orDash(it.Account), it.status(), formatDuration(it.Age), orDash(it.RateLimit))
+	}
+	return w.Flush()
+}
+
+// orDash returns s, or "-" for an empty table cell.
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
 func runSessionCapture(cmd *cobra.Command, args []string) error {
 	rigName, polecatName, err := parseAddress(args[0])
 	if err != nil {
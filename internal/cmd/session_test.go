@@ -1,11 +1,16 @@
 package cmd
 
 import (
+	"bytes"
 	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/quota"
 )
 
 func TestSessionInfoJSONOutput(t *testing.T) {
@@ -78,3 +83,74 @@ func TestSessionInfoJSONOutputNotRunning(t *testing.T) {
 		t.Errorf("running = %v, want false", parsed["running"])
 	}
 }
+
+func TestCollectSessionHeartbeats(t *testing.T) {
+	townRoot := t.TempDir()
+	for _, name := range []string{"gt-gastown-nux", "gt-gastown-atlas", "gt-gastown-echo"} {
+		polecat.TouchSessionHeartbeat(townRoot, name)
+	}
+	// atlas and echo stopped heartbeating; echo's tmux session is gone too.
+	old := time.Now().Add(-time.Hour).UTC()
+	for _, name := range []string{"gt-gastown-atlas", "gt-gastown-echo"} {
+		path := filepath.Join(townRoot, ".runtime", "heartbeats", name+".json")
+		data := []byte(`{"timestamp":"` + old.Format(time.RFC3339Nano) + `"}`)
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sessions := fakeSessionLister{"gt-gastown-nux", "gt-gastown-atlas"}
+	cache := &quota.ScanCache{Results: []quota.ScanResult{
+		{Session: "gt-gastown-nux", AccountHandle: "work"},
+		{Session: "gt-gastown-atlas", AccountHandle: "personal", RateLimited: true},
+	}}
+
+	items, err := collectSessionHeartbeats(townRoot, 3*time.Minute, sessions, cache, false)
+	if err != nil {
+		t.Fatalf("collectSessionHeartbeats: %v", err)
+	}
+	got := make(map[string]SessionHeartbeatItem)
+	for _, it := range items {
+		got[it.SessionID] = it
+	}
+	if len(got) != 3 {
+		t.Fatalf("got %d items, want 3: %+v", len(got), items)
+	}
+	if it := got["gt-gastown-nux"]; it.status() != "active" || it.Account != "work" || it.RateLimit != "ok" {
+		t.Errorf("nux = %+v (status %s)", it, it.status())
+	}
+	if it := got["gt-gastown-atlas"]; it.status() != "stale" || it.Account != "personal" || it.RateLimit != "limited" {
+		t.Errorf("atlas = %+v (status %s)", it, it.status())
+	}
+	if it := got["gt-gastown-echo"]; it.status() != "dead" || it.Account != "" || it.RateLimit != "" {
+		t.Errorf("echo = %+v (status %s)", it, it.status())
+	}
+
+	var buf bytes.Buffer
+	if err := printSessionHeartbeats(&buf, items); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{"SESSION", "ACCOUNT", "HEARTBEAT AGE", "RATE LIMIT", "personal", "limited"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table missing %q:\n%s", want, out)
+		}
+	}
+
+	// echo's heartbeat is stale but its session is gone: dead, not stale or active.
+	if got, want := heartbeatSummary(items, 3*time.Minute), "1 active, 1 stale, 1 dead (threshold 3m0s)"; got != want {
+		t.Errorf("heartbeatSummary = %q, want %q", got, want)
+	}
+
+	staleOnly, err := collectSessionHeartbeats(townRoot, 3*time.Minute, sessions, nil, true)
+	if err != nil {
+		t.Fatalf("collectSessionHeartbeats(stale): %v", err)
+	}
+	if len(staleOnly) != 2 {
+		t.Errorf("stale-only returned %d items, want 2: %+v", len(staleOnly), staleOnly)
+	}
+	for _, it := range staleOnly {
+		if !it.Stale || it.Account != "" {
+			t.Errorf("stale-only item %+v: want stale with no quota data", it)
+		}
+	}
+}
@@ -1,10 +1,15 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 
 	"github.com/spf13/cobra"
@@ -16,7 +21,7 @@ func init() {
 }
 
 var showCmd = &cobra.Command{
-	Use:   "show <bead-id> [flags]",
+	Use:   "show <bead-id>... [flags]",
 	Short: "Show details of a bead",
 	Long: `Displays the full details of a bead by ID.
 
@@ -24,12 +29,18 @@ Delegates to 'bd show' - all bd show flags are supported.
 Works with any bead prefix (gt-, bd-, hq-, etc.) and routes
 to the correct beads database automatically.
 
+With several IDs, each is shown by its own 'bd show' (up to 5 at a time)
+and the results are printed in argument order, separated by "--- <id> ---"
+lines. With --json the results are merged into a single JSON array.
+Exits non-zero if any lookup failed.
+
 Examples:
   gt show gt-abc123          # Show a gastown issue
   gt show hq-xyz789          # Show a town-level bead (convoy, mail, etc.)
   gt show bd-def456          # Show a beads issue
   gt show gt-abc123 --json   # Output as JSON
-  gt show gt-abc123 -v       # Verbose output`,
+  gt show gt-abc123 -v       # Verbose output
+  gt show gt-abc123 hq-xyz789 --json  # Several beads as one JSON array`,
 	DisableFlagParsing: true, // Pass all flags through to bd show
 	RunE:               runShow,
 }
@@ -44,9 +55,135 @@ func runShow(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("bead ID required\n\nUsage: gt show <bead-id> [flags]")
 	}
 
+	if ids, flags := splitBeadIDsAndFlags(args); len(ids) > 1 {
+		bdPath, err := exec.LookPath("bd")
+		if err != nil {
+			return fmt.Errorf("bd not found in PATH: %w", err)
+		}
+		return runBdShowParallel(bdPath, ids, flags, os.Stdout, os.Stderr)
+	}
+
 	return execBdShow(args)
 }
 
+// showParallelLimit bounds concurrent bd show processes for multi-ID gt show.
+const showParallelLimit = 5
+
+// bdShowOutput is the captured result of one bd show subprocess.
+type bdShowOutput struct {
+	stdout []byte
+	stderr []byte
+	err    error
+}
+
+// splitBeadIDsAndFlags separates bead IDs from the arguments passed through
+// to bd show. Only arguments shaped like bead IDs count as IDs; anything else,
+// including flag values such as "value" in "--flag value", stays with the
+// flags in its original order.
+func splitBeadIDsAndFlags(args []string) (ids, flags []string) {
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "-") && isBeadID(arg) {
+			ids = append(ids, arg)
+		} else {
+			flags = append(flags, arg)
+		}
+	}
+	return ids, flags
+}
+
+// hasJSONFlag reports whether flags request JSON output, accepting both
+// "--json" and the "--json=<bool>" form.
+func hasJSONFlag(flags []string) bool {
+	enabled := false
+	for _, f := range flags {
+		if f == "--json" {
+			enabled = true
+		} else if v, ok := strings.CutPrefix(f, "--json="); ok {
+			enabled, _ = strconv.ParseBool(v)
+		}
+	}
+	return enabled
+}
+
+// runBdShowParallel runs "bd show <id> <flags...>" for each ID, at most
+// showParallelLimit at a time, each from its routed rig directory. Output is
+// written in argument order: separated by "--- <id> ---" headers, or merged
+// into one JSON array when flags include --json.
+func runBdShowParallel(bdPath string, ids, flags []string, stdout, stderr io.Writer) error {
+	results := make([]bdShowOutput, len(ids))
+	sem := make(chan struct{}, showParallelLimit)
+	env := stripEnvKey(os.Environ(), "BEADS_DIR")
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var out, errOut bytes.Buffer
+			c := exec.Command(bdPath, append([]string{"show", id}, flags...)...) //nolint:gosec // G204: bd is a trusted internal tool
+			if dir := resolveBeadDir(id); dir != "" && dir != "." {
+				c.Dir = dir
+			}
+			c.Env = env
+			c.Stdout, c.Stderr = &out, &errOut
+			err := c.Run()
+			results[i] = bdShowOutput{stdout: out.Bytes(), stderr: errOut.Bytes(), err: err}
+		}(i, id)
+	}
+	wg.Wait()
+
+	failed := 0
+	for i, r := range results {
+		if r.err != nil {
+			failed++
+			fmt.Fprintf(stderr, "bd show %s: %v\n", ids[i], r.err)
+			_, _ = stderr.Write(r.stderr)
+		}
+	}
+
+	if hasJSONFlag(flags) {
+		merged := []json.RawMessage{}
+		for i, r := range results {
+			if r.err != nil {
+				continue
+			}
+			// bd show --json prints an array; flatten it into the merged one.
+			var items []json.RawMessage
+			if err := json.Unmarshal(r.stdout, &items); err != nil {
+				var single json.RawMessage
+				if err := json.Unmarshal(r.stdout, &single); err != nil {
+					failed++
+					fmt.Fprintf(stderr, "bd show %s: invalid JSON output: %v\n", ids[i], err)
+					continue
+				}
+				items = []json.RawMessage{single}
+			}
+			merged = append(merged, items...)
+		}
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(merged); err != nil {
+			return err
+		}
+	} else {
+		for i, r := range results {
+			if r.err != nil {
+				continue
+			}
+			fmt.Fprintf(stdout, "--- %s ---\n", ids[i])
+			_, _ = stdout.Write(r.stdout)
+		}
+	}
+
+	if failed > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
 // execBdShow replaces the current process with 'bd show'.
 // Resolves the correct rig directory from the bead's prefix via routes.jsonl
 // so that rig-prefixed beads (e.g., myproject-abc) are found in their rig
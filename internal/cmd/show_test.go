@@ -1,6 +1,15 @@
 package cmd
 
-import "testing"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
 
 func TestExtractBeadIDFromArgs(t *testing.T) {
 	tests := []struct {
@@ -47,3 +56,113 @@ func TestStripEnvKey_NoMatch(t *testing.T) {
 		t.Errorf("expected 2 entries (no change), got %d", len(got))
 	}
 }
+
+func writeMockShowBd(t *testing.T) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("mock bd is a shell script")
+	}
+	bdPath := filepath.Join(t.TempDir(), "bd")
+	script := `#!/bin/sh
+# bd show <id> [flags]
+id="$2"
+case "$id" in
+  *-missing) echo "no issue $id" >&2; exit 1 ;;
+esac
+if [ "$3" = "--json" ]; then
+  echo '[{"id":"'"$id"'"}]'
+else
+  echo "details for $id"
+fi
+`
+	if err := os.WriteFile(bdPath, []byte(script), 0755); err != nil {
+		t.Fatalf("write mock bd: %v", err)
+	}
+	return bdPath
+}
+
+func TestSplitBeadIDsAndFlags(t *testing.T) {
+	ids, flags := splitBeadIDsAndFlags([]string{"gt-a", "--json", "hq-b", "-v"})
+	if strings.Join(ids, ",") != "gt-a,hq-b" || strings.Join(flags, ",") != "--json,-v" {
+		t.Errorf("splitBeadIDsAndFlags = %v, %v", ids, flags)
+	}
+
+	// Flag values are not bead IDs and keep their place after the flag.
+	ids, flags = splitBeadIDsAndFlags([]string{"gt-a", "--format", "value", "hq-b"})
+	if strings.Join(ids, ",") != "gt-a,hq-b" || strings.Join(flags, ",") != "--format,value" {
+		t.Errorf("splitBeadIDsAndFlags with flag value = %v, %v", ids, flags)
+	}
+}
+
+func TestHasJSONFlag(t *testing.T) {
+	tests := []struct {
+		flags []string
+		want  bool
+	}{
+		{nil, false},
+		{[]string{"-v"}, false},
+		{[]string{"--json"}, true},
+		{[]string{"--json=true"}, true},
+		{[]string{"--json=1"}, true},
+		{[]string{"--json=false"}, false},
+		{[]string{"--json", "--json=false"}, false},
+	}
+	for _, tt := range tests {
+		if got := hasJSONFlag(tt.flags); got != tt.want {
+			t.Errorf("hasJSONFlag(%v) = %v, want %v", tt.flags, got, tt.want)
+		}
+	}
+}
+
+func TestRunBdShowParallel_TextInOrder(t *testing.T) {
+	bdPath := writeMockShowBd(t)
+	ids := []string{"gt-1", "gt-2", "gt-3", "gt-4", "gt-5", "gt-6", "gt-7"}
+	var stdout, stderr bytes.Buffer
+
+	if err := runBdShowParallel(bdPath, ids, nil, &stdout, &stderr); err != nil {
+		t.Fatalf("runBdShowParallel: %v (stderr: %s)", err, stderr.String())
+	}
+
+	var want strings.Builder
+	for _, id := range ids {
+		fmt.Fprintf(&want, "--- %s ---\ndetails for %s\n", id, id)
+	}
+	if stdout.String() != want.String() {
+		t.Errorf("output =\n%s\nwant\n%s", stdout.String(), want.String())
+	}
+}
+
+func TestRunBdShowParallel_JSONMerged(t *testing.T) {
+	bdPath := writeMockShowBd(t)
+	var stdout, stderr bytes.Buffer
+
+	if err := runBdShowParallel(bdPath, []string{"gt-a", "hq-b"}, []string{"--json"}, &stdout, &stderr); err != nil {
+		t.Fatalf("runBdShowParallel: %v (stderr: %s)", err, stderr.String())
+	}
+
+	var got []struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &got); err != nil {
+		t.Fatalf("output is not a JSON array: %v\n%s", err, stdout.String())
+	}
+	if len(got) != 2 || got[0].ID != "gt-a" || got[1].ID != "hq-b" {
+		t.Errorf("merged = %+v", got)
+	}
+}
+
+func TestRunBdShowParallel_FailureExitsNonZero(t *testing.T) {
+	bdPath := writeMockShowBd(t)
+	var stdout, stderr bytes.Buffer
+
+	err := runBdShowParallel(bdPath, []string{"gt-a", "gt-missing"}, nil, &stdout, &stderr)
+	if err == nil {
+		t.Fatal("expected an error when one lookup fails")
+	}
+	if !strings.Contains(stdout.String(), "details for gt-a") {
+		t.Errorf("successful result missing from output: %s", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "no issue gt-missing") {
+		t.Errorf("failed lookup's stderr not reported: %s", stderr.String())
+	}
+}
@@ -1050,7 +1050,7 @@ func checkCrossRigGuard(beadID, targetAgent, townRoot string) error {
 		return nil
 	}
 
-	beadRig := beads.GetRigNameForPrefix(townRoot, beadPrefix)
+	beadRig := beads.GetRigNameForID(townRoot, beadID)
 
 	if beadRig != targetRig {
 		if beadRig == "" {
@@ -33,7 +33,7 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 		townRoot := filepath.Dir(townBeadsDir)
 		for _, beadID := range beadIDs {
 			prefix := beads.ExtractPrefix(beadID)
-			beadRig := beads.GetRigNameForPrefix(townRoot, prefix)
+			beadRig := beads.GetRigNameForID(townRoot, beadID)
 			if prefix != "" && beadRig != "" && beadRig != rigName {
 				others := make([]string, 0, len(beadIDs)-1)
 				for _, id := range beadIDs {
@@ -290,7 +290,7 @@ func resolveRigFromBeadIDs(beadIDs []string, townRoot string) (string, error) {
 				beadID, strings.Join(beadIDs, " "), beadID)
 		}
 
-		rigName := beads.GetRigNameForPrefix(townRoot, prefix)
+		rigName := beads.GetRigNameForID(townRoot, beadID)
 		if rigName == "" {
 			return "", fmt.Errorf("cannot resolve rig for %s: prefix %q is not mapped to any rig\n\n"+
 				"  The prefix may belong to a town-level bead or the routes are not configured.\n\n"+
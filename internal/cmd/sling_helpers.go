@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/beads"
@@ -46,12 +47,51 @@ func resolveBeadDir(beadID string) string {
 	if err != nil {
 		return "."
 	}
-	townBeadsDir := filepath.Join(townRoot, ".beads")
-	resolved := beads.ResolveBeadsDirForID(townBeadsDir, beadID)
-	// Return the parent of the .beads directory so bd discovers it naturally.
-	// For town-level beads this returns townRoot; for rig beads it returns
-	// the rig's mayor/rig directory (e.g., gastown/mayor/rig).
-	return filepath.Dir(resolved)
+	cache := townRouteCache(townRoot)
+	if cache == nil {
+		return townRoot
+	}
+	rigPath, ok := cache.GetRigPathForID(beadID)
+	if !ok || rigPath == townRoot {
+		return townRoot // Unrouted or town-level bead
+	}
+	// Return the parent of the (possibly redirected) .beads directory so bd
+	// discovers it naturally. For rig beads this is the rig's mayor/rig
+	// directory (e.g., gastown/mayor/rig).
+	return filepath.Dir(beads.ResolveBeadsDir(rigPath))
+}
+
+// beadRouteCache is the process-wide routes cache behind resolveBeadDir.
+// Commands resolve many bead IDs per run; a stat of routes.jsonl is far
+// cheaper than re-reading and parsing it each time.
+var beadRouteCache struct {
+	sync.Mutex
+	cache *beads.RouteCache
+	mod   time.Time
+	size  int64
+}
+
+// townRouteCache returns the cached routes for townRoot, reloading when the
+// town changes or routes.jsonl was modified. Returns nil if routes can't be read.
+func townRouteCache(townRoot string) *beads.RouteCache {
+	var mod time.Time
+	size := int64(-1)
+	if info, err := os.Stat(filepath.Join(beads.GetTownBeadsPath(townRoot), beads.RoutesFileName)); err == nil {
+		mod, size = info.ModTime(), info.Size()
+	}
+
+	beadRouteCache.Lock()
+	defer beadRouteCache.Unlock()
+	c := beadRouteCache.cache
+	if c != nil && c.TownRoot() == townRoot && mod.Equal(beadRouteCache.mod) && size == beadRouteCache.size {
+		return c
+	}
+	c, err := beads.NewRouteCacheFromDir(townRoot)
+	if err != nil {
+		return nil
+	}
+	beadRouteCache.cache, beadRouteCache.mod, beadRouteCache.size = c, mod, size
+	return c
 }
 
 // resolveBeadDirFromRigsJSON looks up the rig directory from rigs.json using prefix.
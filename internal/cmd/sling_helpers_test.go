@@ -236,3 +236,65 @@ func TestIsSlingConfigError(t *testing.T) {
 		})
 	}
 }
+
+func TestTownRouteCache_ReloadsOnChange(t *testing.T) {
+	townRoot := t.TempDir()
+	beadsDir := filepath.Join(townRoot, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	routesPath := filepath.Join(beadsDir, beads.RoutesFileName)
+	if err := os.WriteFile(routesPath, []byte(`{"prefix": "gt-", "path": "gastown/mayor/rig"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	c := townRouteCache(townRoot)
+	if c == nil {
+		t.Fatal("townRouteCache returned nil")
+	}
+	if again := townRouteCache(townRoot); again != c {
+		t.Error("expected the cached instance when routes.jsonl is unchanged")
+	}
+	if _, ok := c.GetRigPath("bd-"); ok {
+		t.Fatal("bd- should not be routed yet")
+	}
+
+	if err := os.WriteFile(routesPath, []byte(`{"prefix": "gt-", "path": "gastown/mayor/rig"}
+{"prefix": "bd-", "path": "beads/mayor/rig"}
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := townRouteCache(townRoot).GetRigPath("bd-"); !ok || got != filepath.Join(townRoot, "beads/mayor/rig") {
+		t.Errorf("after routes change GetRigPath(bd-) = %q, %v", got, ok)
+	}
+}
+
+func TestResolveBeadDir_ThroughSymlinkedDir(t *testing.T) {
+	// The CWD is a symlink from outside the town into a polecat dir (e.g. a
+	// unified memory dir), so the town is not among its lexical parents.
+	townRoot, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range []string{"mayor", ".beads", "gastown/mayor/rig/.beads", "gastown/polecats/worker"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(townRoot, "mayor", "town.json"), []byte(`{}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := beads.WriteRoutes(filepath.Join(townRoot, ".beads"), []beads.Route{{Prefix: "gt-", Path: "gastown/mayor/rig"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	link := filepath.Join(t.TempDir(), "memory")
+	if err := os.Symlink(filepath.Join(townRoot, "gastown", "polecats", "worker"), link); err != nil {
+		t.Skipf("symlink not supported: %v", err)
+	}
+	t.Chdir(link)
+
+	if got, want := resolveBeadDir("gt-abc123"), filepath.Join(townRoot, "gastown", "mayor", "rig"); got != want {
+		t.Errorf("resolveBeadDir(gt-abc123) = %q, want %q", got, want)
+	}
+}
@@ -251,7 +251,7 @@ func resolveRigForBead(townRoot, beadID string) string {
 	if prefix == "" {
 		return ""
 	}
-	return beads.GetRigNameForPrefix(townRoot, prefix)
+	return beads.GetRigNameForID(townRoot, beadID)
 }
 
 // resolveFormula determines the formula name from user flags and rig settings.
@@ -11,6 +11,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/formula"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/style"
@@ -630,7 +631,7 @@ func findFormula(name string) (string, error) {
 	}
 
 	// Add GT_ROOT formulas if set
-	if gtRoot := os.Getenv("GT_ROOT"); gtRoot != "" {
+	if gtRoot, _, _ := config.ResolveRootEnv(); gtRoot != "" {
 		searchPaths = append(searchPaths, filepath.Join(gtRoot, ".beads", "formulas"))
 	}
 
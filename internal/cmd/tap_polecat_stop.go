@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
@@ -53,7 +54,7 @@ func runTapPolecatStop(cmd *cobra.Command, args []string) error {
 	// Find town root for heartbeat check
 	townRoot, _, _ := workspace.FindFromCwdWithFallback()
 	if townRoot == "" {
-		townRoot = os.Getenv("GT_TOWN_ROOT")
+		townRoot, _, _ = config.ResolveRootEnv()
 	}
 	if townRoot == "" {
 		return nil // Can't find workspace — exit quietly
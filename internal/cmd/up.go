@@ -532,6 +532,7 @@ func ensureDaemon(townRoot string) error {
 
 	cmd := exec.Command(gtPath, "daemon", "run")
 	cmd.Dir = townRoot
+	cmd.Env = daemon.Env(townRoot)
 	// Detach from parent I/O for background daemon (uses its own logging)
 	cmd.Stdin = nil
 	cmd.Stdout = nil
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/buildinfo"
 	"github.com/steveyegge/gastown/internal/version"
 )
 
@@ -70,6 +71,9 @@ func init() {
 	if Commit != "" {
 		version.SetCommit(Commit)
 	}
+
+	// Provenance stamps on runtime state files record this binary.
+	buildinfo.Set(Version, resolveCommitHash())
 }
 
 func resolveCommitHash() string {
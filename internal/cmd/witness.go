@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/session"
@@ -80,7 +81,8 @@ var witnessStatusCmd = &cobra.Command{
 	Short: "Show witness status",
 	Long: `Show the status of a rig's Witness.
 
-Displays running state, monitored polecats, and statistics.`,
+Displays running state, monitored polecats, and the summary of the most
+recent patrol scan (.runtime/patrol-summary.json in the rig).`,
 	Args: cobra.ExactArgs(1),
 	RunE: runWitnessStatus,
 }
@@ -225,17 +227,18 @@ func runWitnessStop(cmd *cobra.Command, args []string) error {
 
 // WitnessStatusOutput is the JSON output format for witness status.
 type WitnessStatusOutput struct {
-	Running           bool     `json:"running"`
-	RigName           string   `json:"rig_name"`
-	Session           string   `json:"session,omitempty"`
-	MonitoredPolecats []string `json:"monitored_polecats,omitempty"`
+	Running           bool                   `json:"running"`
+	RigName           string                 `json:"rig_name"`
+	Session           string                 `json:"session,omitempty"`
+	MonitoredPolecats []string               `json:"monitored_polecats,omitempty"`
+	LastPatrol        *witness.PatrolSummary `json:"last_patrol,omitempty"`
 }
 
 func runWitnessStatus(cmd *cobra.Command, args []string) error {
 	rigName := args[0]
 
 	// Get rig for polecat info
-	_, r, err := getRig(rigName)
+	townRoot, r, err := getRig(rigName)
 	if err != nil {
 		return err
 	}
@@ -249,12 +252,18 @@ func runWitnessStatus(cmd *cobra.Command, args []string) error {
 	// Polecats come from rig config, not state file
 	polecats := r.Polecats
 
+	lastPatrol, err := witness.LoadPatrolSummary(witness.PatrolSummaryPath(townRoot, rigName))
+	if err != nil {
+		style.PrintWarning("could not read patrol summary: %v", err)
+	}
+
 	// JSON output
 	if witnessStatusJSON {
 		output := WitnessStatusOutput{
 			Running:           running,
 			RigName:           rigName,
 			MonitoredPolecats: polecats,
+			LastPatrol:        lastPatrol,
 		}
 		if sessionInfo != nil {
 			output.Session = sessionInfo.Name
@@ -286,6 +295,21 @@ func runWitnessStatus(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Show the latest patrol summary
+	fmt.Printf("\n  %s\n", style.Bold.Render("Last Patrol:"))
+	switch {
+	case lastPatrol == nil:
+		fmt.Printf("    %s\n", style.Dim.Render("(no patrol recorded)"))
+	case lastPatrol.IsClean():
+		fmt.Printf("    %s clean (%s)\n", style.Success.Render("✓"), formatAge(lastPatrol.Timestamp))
+	default:
+		fmt.Printf("    %d finding(s) (%s): %d stale, %d orphan, %d pending, %d no action, %d unknown, %d critical\n",
+			lastPatrol.Total, formatAge(lastPatrol.Timestamp),
+			lastPatrol.StaleCount, lastPatrol.OrphanCount, lastPatrol.PendingCount,
+			lastPatrol.NoneCount, lastPatrol.UnknownCount, lastPatrol.CriticalCount)
+		fmt.Printf("    Affected: %s\n", strings.Join(lastPatrol.AffectedPolecats, ", "))
+	}
+
 	return nil
 }
 
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/witness"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Witness watch flags
+var (
+	witnessWatchJSON     bool
+	witnessWatchInterval time.Duration
+	witnessWatchAll      bool
+)
+
+var witnessWatchCmd = &cobra.Command{
+	Use:   "watch [rig]",
+	Short: "Stream witness patrol activity live",
+	Long: `Follow the witness patrol feed for a rig as events arrive.
+
+Every patrol appends structured events to <rig>/.runtime/witness-patrol.jsonl:
+patrol-started, polecat-examined (with classification), action-executed
+(with outcome), and patrol-finished (with a summary). Each event carries the
+patrol run ID so overlapping patrols stay legible.
+
+The feed is polled; no filesystem notification support is required.
+Press Ctrl-C to stop.
+
+Examples:
+  gt witness watch                  # infer rig from cwd
+  gt witness watch greenplace
+  gt witness watch greenplace --all # replay the retained feed first
+  gt witness watch --json | jq .`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWitnessWatch,
+}
+
+func init() {
+	witnessWatchCmd.Flags().BoolVar(&witnessWatchJSON, "json", false, "Emit raw JSONL events (for piping)")
+	witnessWatchCmd.Flags().DurationVar(&witnessWatchInterval, "interval", time.Second, "Polling interval")
+	witnessWatchCmd.Flags().BoolVar(&witnessWatchAll, "all", false, "Print retained events before following")
+
+	witnessCmd.AddCommand(witnessWatchCmd)
+}
+
+func runWitnessWatch(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigName := ""
+	if len(args) > 0 {
+		rigName = args[0]
+	}
+	if rigName == "" {
+		rigName, err = inferRigFromCwd(townRoot)
+		if err != nil {
+			return fmt.Errorf("could not determine rig: %w\nUsage: gt witness watch <rig>", err)
+		}
+	}
+	if witnessWatchInterval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	path := witness.PatrolFeedPath(townRoot, rigName)
+	var offset int64
+	if !witnessWatchAll {
+		offset = witness.PatrolFeedEnd(path)
+	}
+	reader := witness.NewPatrolFeedReader(path, offset)
+
+	if !witnessWatchJSON {
+		fmt.Printf("%s Watching witness patrols for %s (Ctrl-C to stop)\n\n",
+			style.Bold.Render("●"), rigName)
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGTERM, syscall.SIGINT)
+	defer signal.Stop(sigCh)
+
+	ticker := time.NewTicker(witnessWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := reader.Poll()
+		if err != nil {
+			style.PrintWarning("reading patrol feed: %v", err)
+		}
+		for _, ev := range events {
+			if err := writePatrolEvent(os.Stdout, ev, witnessWatchJSON); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-sigCh:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// writePatrolEvent renders one patrol event as a JSON line or a human line.
+func writePatrolEvent(w io.Writer, ev witness.PatrolEvent, asJSON bool) error {
+	if asJSON {
+		return json.NewEncoder(w).Encode(ev)
+	}
+	_, err := fmt.Fprintln(w, formatPatrolEvent(ev))
+	return err
+}
+
+// formatPatrolEvent returns a one-line human rendering of a patrol event.
+func formatPatrolEvent(ev witness.PatrolEvent) string {
+	prefix := fmt.Sprintf("%s [%s]", ev.Time.Local().Format("15:04:05"), ev.RunID)
+	switch ev.Type {
+	case witness.PatrolEventStarted:
+		return fmt.Sprintf("%s %s patrol started", prefix, style.Bold.Render("▶"))
+	case witness.PatrolEventExamined:
+		return fmt.Sprintf("%s   %s %s", prefix, ev.Polecat, style.Warning.Render(ev.Classification))
+	case witness.PatrolEventAction:
+		outcome := style.Success.Render(ev.Outcome)
+		if ev.Outcome != "ok" {
+			outcome = style.Error.Render(ev.Outcome)
+		}
+		action := ev.Action
+		if action == "" {
+			action = "(none)"
+		}
+		return fmt.Sprintf("%s   %s → %s: %s", prefix, ev.Polecat, action, outcome)
+	case witness.PatrolEventFinished:
+		line := fmt.Sprintf("%s %s patrol finished", prefix, style.Bold.Render("■"))
+		if s := ev.Summary; s != nil {
+			counts := fmt.Sprintf("checked %d, zombies %d", s.Checked, s.Zombies)
+			if s.RateLimited > 0 {
+				counts += fmt.Sprintf(", rate-limited %d", s.RateLimited)
+			}
+			counts += fmt.Sprintf(", stalled %d, completions %d, errors %d", s.Stalled, s.Completions, s.Errors)
+			line += style.Dim.Render(" (" + counts + ")")
+		}
+		return line
+	default:
+		return fmt.Sprintf("%s %s", prefix, ev.Type)
+	}
+}
@@ -36,6 +36,8 @@ const (
 	// AgentOmp is Oh My Pi (OMP) — Pi fork with hook-based lifecycle.
 	// Inspired by github.com/ProbabilityEngineer/pi-mono gastown integration.
 	AgentOmp AgentPreset = "omp"
+	// AgentAider is Aider (REPL with a "> " prompt, no hooks).
+	AgentAider AgentPreset = "aider"
 )
 
 // AgentPresetInfo contains the configuration details for an agent preset.
@@ -427,6 +429,26 @@ var builtinPresets = map[AgentPreset]*AgentPresetInfo{
 			PromptFlag: "--prompt",
 		},
 	},
+	AgentAider: {
+		Name:                AgentAider,
+		Command:             "aider",
+		Args:                []string{"--yes-always"},
+		ProcessNames:        []string{"aider"}, // not python: any interpreter in the pane would match
+		SessionIDEnv:        "",
+		ResumeFlag:          "", // No resume support; chat history is per-directory
+		ResumeStyle:         "",
+		SupportsHooks:       false,
+		SupportsForkSession: false,
+		NonInteractive: &NonInteractiveConfig{
+			PromptFlag: "--message",
+		},
+		// Runtime defaults. Positional args are files to edit, not a prompt,
+		// so the startup prompt is delivered by nudge once "> " appears.
+		PromptMode:        "none",
+		ReadyPromptPrefix: "> ",
+		ReadyDelayMs:      0,
+		InstructionsFile:  "AGENTS.md",
+	},
 }
 
 // Registry state with proper synchronization.
@@ -535,13 +557,63 @@ func GetAgentPreset(name AgentPreset) *AgentPresetInfo {
 	return globalRegistry.Agents[string(name)]
 }
 
-// GetAgentPresetByName returns the preset info by string name.
+// GetAgentPresetByName returns the preset info by string name, ignoring case
+// ("Claude" finds "claude"). An exact match wins over a case-insensitive one.
 // Returns nil if not found, allowing caller to fall back to defaults.
 func GetAgentPresetByName(name string) *AgentPresetInfo {
 	registryMu.Lock()
 	initRegistryLocked()
 	defer registryMu.Unlock()
-	return globalRegistry.Agents[name]
+	info, _ := lookupAgentPresetLocked(name)
+	return info
+}
+
+// GetAgentPresetFuzzy resolves minor name variations to a preset and returns
+// it with the registry name it resolved to. It tries GetAgentPresetByName
+// first, then the longest preset name that prefixes the lowercased name at
+// a word boundary, so "claude-3-opus" resolves to "claude" and "codex-mini"
+// to "codex" (but "pilot" does not resolve to "pi"). Returns nil, "" if
+// nothing matches.
+//
+// Callers that pick an agent from free-form model or provider names should
+// use this as a fallback before giving up on a known preset.
+func GetAgentPresetFuzzy(name string) (*AgentPresetInfo, string) {
+	registryMu.Lock()
+	initRegistryLocked()
+	defer registryMu.Unlock()
+	if info, canonical := lookupAgentPresetLocked(name); info != nil {
+		return info, canonical
+	}
+
+	lower := strings.ToLower(name)
+	var best string
+	for key := range globalRegistry.Agents {
+		k := strings.ToLower(key)
+		if len(k) <= len(best) || len(lower) <= len(k) || !strings.HasPrefix(lower, k) {
+			continue
+		}
+		if c := lower[len(k)]; c == '-' || c == '_' || c == '.' || c == ' ' || (c >= '0' && c <= '9') {
+			best = key
+		}
+	}
+	if best == "" {
+		return nil, ""
+	}
+	return globalRegistry.Agents[best], best
+}
+
+// lookupAgentPresetLocked finds a preset by exact name, then by
+// case-insensitive name. Caller must hold registryMu.
+func lookupAgentPresetLocked(name string) (*AgentPresetInfo, string) {
+	if info, ok := globalRegistry.Agents[name]; ok {
+		return info, name
+	}
+	for key, info := range globalRegistry.Agents {
+		if strings.EqualFold(key, name) {
+			return info, key
+		}
+	}
+	return nil, ""
 }
 
 // ListAgentPresets returns all known agent preset names.
@@ -19,7 +19,7 @@ func isClaudeCmd(cmd string) bool {
 func TestBuiltinPresets(t *testing.T) {
 	t.Parallel()
 	// Ensure all built-in presets are accessible
-	presets := []AgentPreset{AgentClaude, AgentGemini, AgentCodex, AgentCursor, AgentAuggie, AgentAmp, AgentOpenCode, AgentCopilot, AgentPi, AgentOmp}
+	presets := []AgentPreset{AgentClaude, AgentGemini, AgentCodex, AgentCursor, AgentAuggie, AgentAmp, AgentOpenCode, AgentCopilot, AgentPi, AgentOmp, AgentAider}
 
 	for _, preset := range presets {
 		info := GetAgentPreset(preset)
@@ -52,11 +52,14 @@ func TestGetAgentPresetByName(t *testing.T) {
 		{"cursor", AgentCursor, false},
 		{"auggie", AgentAuggie, false},
 		{"amp", AgentAmp, false},
-		{"aider", "", true},                // Not built-in, can be added via config
+		{"aider", AgentAider, false},       // Aider REPL
 		{"opencode", AgentOpenCode, false}, // Built-in multi-model CLI agent
 		{"copilot", AgentCopilot, false},   // Built-in GitHub Copilot CLI agent
 		{"pi", AgentPi, false},             // Pi Coding Agent
 		{"omp", AgentOmp, false},           // Oh My Pi
+		{"Claude", AgentClaude, false},     // case-insensitive
+		{"GEMINI", AgentGemini, false},
+		{"claude-3", "", true}, // versioned names need GetAgentPresetFuzzy
 		{"unknown", "", true},
 	}
 
@@ -76,6 +79,38 @@ func TestGetAgentPresetByName(t *testing.T) {
 	}
 }
 
+func TestGetAgentPresetFuzzy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		wantCanonical string
+	}{
+		{"Claude", "claude"},
+		{"claude-3-opus", "claude"},
+		{"GEMINI", "gemini"},
+		{"codex-mini", "codex"},
+		{"Gemini-2.5-pro", "gemini"},
+		{"opencode", "opencode"},
+		{"pilot", ""}, // "pi" only matches at a word boundary
+		{"unknown", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, canonical := GetAgentPresetFuzzy(tt.name)
+			if canonical != tt.wantCanonical {
+				t.Fatalf("GetAgentPresetFuzzy(%q) canonical = %q, want %q", tt.name, canonical, tt.wantCanonical)
+			}
+			if (info == nil) != (tt.wantCanonical == "") {
+				t.Fatalf("GetAgentPresetFuzzy(%q) info = %v", tt.name, info)
+			}
+			if info != nil && string(info.Name) != tt.wantCanonical {
+				t.Errorf("GetAgentPresetFuzzy(%q).Name = %q, want %q", tt.name, info.Name, tt.wantCanonical)
+			}
+		})
+	}
+}
+
 func TestRuntimeConfigFromPreset(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -135,7 +170,7 @@ func TestIsKnownPreset(t *testing.T) {
 		{"cursor", true},
 		{"auggie", true},
 		{"amp", true},
-		{"aider", false},   // Not built-in, can be added via config
+		{"aider", true},    // Aider REPL
 		{"opencode", true}, // Built-in multi-model CLI agent
 		{"copilot", true},  // Built-in GitHub Copilot CLI agent
 		{"pi", true},       // Pi Coding Agent
@@ -207,6 +242,15 @@ func TestLoadAgentRegistry(t *testing.T) {
 	ResetRegistryForTesting()
 }
 
+func TestAiderProcessNamesExcludeInterpreters(t *testing.T) {
+	// A bare python/python3 pane command must not count as a running aider.
+	for _, name := range GetProcessNames("aider") {
+		if name == "python" || name == "python3" {
+			t.Errorf("aider ProcessNames include generic interpreter %q", name)
+		}
+	}
+}
+
 func TestGetProcessNamesRespectsRegistryOverride(t *testing.T) {
 	// Regression test: settings/agents.json overrides must be visible to
 	// GetProcessNames so that liveness checks (IsAgentAlive, daemon heartbeat,
@@ -638,7 +682,7 @@ func TestGetProcessNames(t *testing.T) {
 func TestListAgentPresetsMatchesConstants(t *testing.T) {
 	t.Parallel()
 	// Ensure all AgentPreset constants are returned by ListAgentPresets
-	allConstants := []AgentPreset{AgentClaude, AgentGemini, AgentCodex, AgentCursor, AgentAuggie, AgentAmp, AgentOpenCode, AgentCopilot, AgentPi, AgentOmp}
+	allConstants := []AgentPreset{AgentClaude, AgentGemini, AgentCodex, AgentCursor, AgentAuggie, AgentAmp, AgentOpenCode, AgentCopilot, AgentPi, AgentOmp, AgentAider}
 	presets := ListAgentPresets()
 
 	// Convert to map for quick lookup
@@ -27,7 +27,7 @@ type AgentEnvConfig struct {
 	AgentName string
 
 	// TownRoot is the root of the Gas Town workspace.
-	// Sets GT_ROOT environment variable.
+	// Sets GT_ROOT (and the legacy GT_TOWN_ROOT) environment variables.
 	TownRoot string
 
 	// RuntimeConfigDir is the optional CLAUDE_CONFIG_DIR path
@@ -136,7 +136,9 @@ func AgentEnv(cfg AgentEnvConfig) map[string]string {
 	// Only set GT_ROOT if provided
 	// Empty values would override tmux session environment
 	if cfg.TownRoot != "" {
-		env["GT_ROOT"] = cfg.TownRoot
+		for k, v := range RootEnv(cfg.TownRoot) {
+			env[k] = v
+		}
 		// Prevent git from walking up to umbrella repo when running in rig worktrees.
 		// This stops accidental commits to the umbrella when running git commands from
 		// intermediate directories (e.g., polecats/) that don't have their own .git.
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -35,6 +36,11 @@ var (
 	ErrMissingField = errors.New("missing required field")
 )
 
+// ReadTownJSON loads and validates townRoot's mayor/town.json.
+func ReadTownJSON(townRoot string) (*TownConfig, error) {
+	return LoadTownConfig(filepath.Join(townRoot, constants.DirMayor, constants.FileTownJSON))
+}
+
 // LoadTownConfig loads and validates a town configuration file.
 func LoadTownConfig(path string) (*TownConfig, error) {
 	data, err := os.ReadFile(path) //nolint:gosec // G304: path is from trusted config location
@@ -770,7 +776,20 @@ func RemoveRigFromDaemonPatrols(townRoot string, rigName string) error {
 }
 
 // LoadAccountsConfig loads and validates an accounts configuration file.
+// See LoadAndValidate for stricter validation.
 func LoadAccountsConfig(path string) (*AccountsConfig, error) {
+	config, err := readAccountsConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAccountsConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// readAccountsConfig reads and parses an accounts config without validating it.
+func readAccountsConfig(path string) (*AccountsConfig, error) {
 	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed internally, not from user input
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -783,11 +802,6 @@ func LoadAccountsConfig(path string) (*AccountsConfig, error) {
 	if err := json.Unmarshal(data, &config); err != nil {
 		return nil, fmt.Errorf("parsing accounts config: %w", err)
 	}
-
-	if err := validateAccountsConfig(&config); err != nil {
-		return nil, err
-	}
-
 	return &config, nil
 }
 
@@ -836,6 +850,67 @@ func validateAccountsConfig(c *AccountsConfig) error {
 	return nil
 }
 
+// orgIDPattern matches an Anthropic organization UUID.
+var orgIDPattern = regexp.MustCompile(`^[a-f0-9-]{36}$`)
+
+// Validate checks the accounts config more strictly than loading does and
+// returns every problem found, so callers can report them all at once:
+// at least one account must be defined, each account needs a config_dir,
+// no two accounts may share a config_dir, and org_id (if set) must be a
+// UUID. Errors are ordered by account handle.
+func (c *AccountsConfig) Validate() []error {
+	var errs []error
+	if c.Version > CurrentAccountsVersion {
+		errs = append(errs, fmt.Errorf("%w: got %d, max supported %d", ErrInvalidVersion, c.Version, CurrentAccountsVersion))
+	}
+	if len(c.Accounts) == 0 {
+		return append(errs, fmt.Errorf("%w: no accounts defined", ErrMissingField))
+	}
+	if c.Default != "" {
+		if _, ok := c.Accounts[c.Default]; !ok {
+			errs = append(errs, fmt.Errorf("%w: default account '%s' not found in accounts", ErrMissingField, c.Default))
+		}
+	}
+
+	handles := make([]string, 0, len(c.Accounts))
+	for handle := range c.Accounts {
+		handles = append(handles, handle)
+	}
+	sort.Strings(handles)
+
+	dirOwner := make(map[string]string) // cleaned config dir -> first handle using it
+	for _, handle := range handles {
+		acct := c.Accounts[handle]
+		if acct.ConfigDir == "" {
+			errs = append(errs, fmt.Errorf("%w: config_dir for account '%s'", ErrMissingField, handle))
+		} else {
+			dir := filepath.Clean(acct.ConfigDir)
+			if owner, ok := dirOwner[dir]; ok {
+				errs = append(errs, fmt.Errorf("accounts '%s' and '%s' share config_dir %s", owner, handle, acct.ConfigDir))
+			} else {
+				dirOwner[dir] = handle
+			}
+		}
+		if acct.OrgID != "" && !orgIDPattern.MatchString(acct.OrgID) {
+			errs = append(errs, fmt.Errorf("account '%s': org_id %q is not a UUID", handle, acct.OrgID))
+		}
+	}
+	return errs
+}
+
+// LoadAndValidate loads an accounts config and runs Validate on it. All
+// validation problems are joined into the returned error (one per line).
+func LoadAndValidate(path string) (*AccountsConfig, error) {
+	config, err := readAccountsConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	if errs := config.Validate(); len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return config, nil
+}
+
 // NewAccountsConfig creates a new AccountsConfig with defaults.
 func NewAccountsConfig() *AccountsConfig {
 	return &AccountsConfig{
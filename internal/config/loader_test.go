@@ -2,6 +2,7 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -663,6 +664,89 @@ func TestAccountsConfigRoundTrip(t *testing.T) {
 	}
 }
 
+func TestAccountsConfigValidate(t *testing.T) {
+	t.Parallel()
+	const orgID = "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0"
+	tests := []struct {
+		name     string
+		accounts map[string]Account
+		wantErrs int
+	}{
+		{
+			name:     "valid",
+			accounts: map[string]Account{"work": {ConfigDir: "~/.claude-accounts/work", OrgID: orgID}},
+		},
+		{
+			name:     "no accounts",
+			accounts: nil,
+			wantErrs: 1,
+		},
+		{
+			name:     "empty config_dir",
+			accounts: map[string]Account{"work": {Email: "w@example.com"}},
+			wantErrs: 1,
+		},
+		{
+			name: "shared config_dir",
+			accounts: map[string]Account{
+				"a": {ConfigDir: "/accounts/shared"},
+				"b": {ConfigDir: "/accounts/shared/"},
+			},
+			wantErrs: 1,
+		},
+		{
+			name:     "org_id not a UUID",
+			accounts: map[string]Account{"work": {ConfigDir: "/accounts/work", OrgID: "org-1"}},
+			wantErrs: 1,
+		},
+		{
+			name:     "org_id uppercase",
+			accounts: map[string]Account{"work": {ConfigDir: "/accounts/work", OrgID: strings.ToUpper(orgID)}},
+			wantErrs: 1,
+		},
+		{
+			name: "all errors reported at once",
+			accounts: map[string]Account{
+				"a": {},
+				"b": {ConfigDir: "/accounts/b", OrgID: "nope"},
+				"c": {ConfigDir: "/accounts/b"},
+			},
+			wantErrs: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &AccountsConfig{Version: CurrentAccountsVersion, Accounts: tt.accounts}
+			if errs := c.Validate(); len(errs) != tt.wantErrs {
+				t.Errorf("Validate() = %v, want %d error(s)", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestLoadAndValidate(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	if _, err := LoadAndValidate(filepath.Join(dir, "missing.json")); !errors.Is(err, ErrNotFound) {
+		t.Errorf("LoadAndValidate(missing) = %v, want ErrNotFound", err)
+	}
+
+	path := filepath.Join(dir, "accounts.json")
+	data := `{"version":1,"accounts":{"a":{"config_dir":""},"b":{"config_dir":"/x","org_id":"bad"}}}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err := LoadAndValidate(path)
+	if err == nil {
+		t.Fatal("LoadAndValidate(invalid) = nil error")
+	}
+	if lines := strings.Split(err.Error(), "\n"); len(lines) != 2 {
+		t.Errorf("error = %q, want one line per problem", err)
+	}
+}
+
 func TestAccountsConfigValidation(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -5586,3 +5670,24 @@ func TestBuildStartupCommandWithAgentOverride_NoDoubleSettingsOnNonOverridePath(
 		t.Errorf("default Claude agent on polecat role should still get --settings, got: %q", cmd)
 	}
 }
+
+func TestReadTownJSON(t *testing.T) {
+	townRoot := t.TempDir()
+	if _, err := ReadTownJSON(townRoot); !errors.Is(err, ErrNotFound) {
+		t.Errorf("ReadTownJSON without town.json: err = %v, want ErrNotFound", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(townRoot, "mayor", "town.json"), []byte(`{"type":"town","name":"hq"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := ReadTownJSON(townRoot)
+	if err != nil {
+		t.Fatalf("ReadTownJSON: %v", err)
+	}
+	if cfg.Name != "hq" {
+		t.Errorf("Name = %q, want %q", cfg.Name, "hq")
+	}
+}
@@ -111,6 +111,7 @@ const (
 	DefaultWitnessMaxBeadRespawns        = 3
 	DefaultWitnessDoneIntentStuckTimeout = 60 * time.Second
 	DefaultWitnessDoneIntentRecentGrace  = 30 * time.Second
+	DefaultWitnessZombieGracePeriod      = 0 // disabled
 )
 
 // LoadOperationalConfig loads operational config from a town root.
@@ -732,3 +733,11 @@ func (wt *WitnessThresholds) DoneIntentRecentGraceD() time.Duration {
 	}
 	return DefaultWitnessDoneIntentRecentGrace
 }
+
+// ZombieGracePeriodD returns the configured or default zombie grace period.
+func (wt *WitnessThresholds) ZombieGracePeriodD() time.Duration {
+	if wt != nil {
+		return ParseDurationOrDefault(wt.ZombieGracePeriod, DefaultWitnessZombieGracePeriod)
+	}
+	return DefaultWitnessZombieGracePeriod
+}
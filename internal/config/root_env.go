@@ -0,0 +1,107 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Town root environment variables.
+//
+// GT_ROOT is the authoritative name. GT_TOWN_ROOT is the legacy name set by
+// older shell integration and session code; it is still written alongside
+// GT_ROOT for one deprecation window so that hooks and scripts that only know
+// the old name keep working. All Go readers go through ResolveRootEnv.
+const (
+	// EnvRoot is the authoritative town root variable.
+	EnvRoot = "GT_ROOT"
+
+	// EnvTownRootLegacy is the legacy town root variable. Read it only via
+	// ResolveRootEnv and write it only via RootEnv or SetRootEnv.
+	EnvTownRootLegacy = "GT_TOWN_ROOT"
+)
+
+var (
+	// rootEnvWarnOnce ensures the legacy-variable warning is emitted at most
+	// once per process, regardless of how many call sites resolve the root.
+	rootEnvWarnOnce sync.Once
+
+	// rootEnvWarnOut is where the deprecation warning is written.
+	// Tests replace it to capture output.
+	rootEnvWarnOut io.Writer = os.Stderr
+)
+
+// ResolveRootEnv returns the town root from the process environment.
+//
+// GT_ROOT takes precedence. GT_TOWN_ROOT is consulted only when GT_ROOT is
+// unset, in which case deprecated is true and a single deprecation warning
+// naming the calling component is written to stderr (once per process).
+// source is the name of the variable the value came from, or "" if neither
+// is set.
+func ResolveRootEnv() (value, source string, deprecated bool) {
+	if v := os.Getenv(EnvRoot); v != "" {
+		return v, EnvRoot, false
+	}
+	if v := os.Getenv(EnvTownRootLegacy); v != "" {
+		warnLegacyRootEnv(callerComponent(2))
+		return v, EnvTownRootLegacy, true
+	}
+	return "", "", false
+}
+
+// RootEnv returns the environment variables that identify townRoot.
+// Both GT_ROOT and the legacy GT_TOWN_ROOT are included during the
+// deprecation window. Returns nil for an empty townRoot so callers never
+// export empty values that would shadow an inherited environment.
+func RootEnv(townRoot string) map[string]string {
+	if townRoot == "" {
+		return nil
+	}
+	return map[string]string{
+		EnvRoot:           townRoot,
+		EnvTownRootLegacy: townRoot,
+	}
+}
+
+// SetRootEnv writes townRoot under both root variable names using set, which
+// is typically a tmux SetEnvironment/SetGlobalEnvironment method or os.Setenv.
+// GT_ROOT is written first so it is never missing when the legacy name exists.
+func SetRootEnv(set func(key, value string) error, townRoot string) error {
+	for _, key := range []string{EnvRoot, EnvTownRootLegacy} {
+		if err := set(key, townRoot); err != nil {
+			return fmt.Errorf("setting %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// warnLegacyRootEnv emits the one-time deprecation warning for GT_TOWN_ROOT.
+func warnLegacyRootEnv(component string) {
+	rootEnvWarnOnce.Do(func() {
+		fmt.Fprintf(rootEnvWarnOut,
+			"WARNING: %s is deprecated and will be removed; set %s instead (read by %s)\n",
+			EnvTownRootLegacy, EnvRoot, component)
+	})
+}
+
+// callerComponent returns a short "package.Function" name for the caller
+// skip frames above it, used to tell users which component still depends
+// on the legacy variable.
+func callerComponent(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip)
+	if !ok {
+		return "unknown"
+	}
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return "unknown"
+	}
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,190 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// captureRootEnvWarning redirects the deprecation warning and resets the
+// once-per-process guard for the duration of the test.
+func captureRootEnvWarning(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	oldOut := rootEnvWarnOut
+	rootEnvWarnOut = &buf
+	rootEnvWarnOnce = sync.Once{}
+	t.Cleanup(func() {
+		rootEnvWarnOut = oldOut
+		rootEnvWarnOnce = sync.Once{}
+	})
+	return &buf
+}
+
+func TestResolveRootEnv_Precedence(t *testing.T) {
+	tests := []struct {
+		name           string
+		root, legacy   string
+		wantValue      string
+		wantSource     string
+		wantDeprecated bool
+	}{
+		{"neither set", "", "", "", "", false},
+		{"only GT_ROOT", "/new", "", "/new", EnvRoot, false},
+		{"only GT_TOWN_ROOT", "", "/old", "/old", EnvTownRootLegacy, true},
+		{"both set, GT_ROOT wins", "/new", "/old", "/new", EnvRoot, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			captureRootEnvWarning(t)
+			t.Setenv(EnvRoot, tt.root)
+			t.Setenv(EnvTownRootLegacy, tt.legacy)
+
+			value, source, deprecated := ResolveRootEnv()
+			if value != tt.wantValue || source != tt.wantSource || deprecated != tt.wantDeprecated {
+				t.Errorf("ResolveRootEnv() = (%q, %q, %v), want (%q, %q, %v)",
+					value, source, deprecated, tt.wantValue, tt.wantSource, tt.wantDeprecated)
+			}
+		})
+	}
+}
+
+func TestResolveRootEnv_WarnsOncePerProcess(t *testing.T) {
+	buf := captureRootEnvWarning(t)
+	t.Setenv(EnvRoot, "")
+	t.Setenv(EnvTownRootLegacy, "/old")
+
+	for i := 0; i < 3; i++ {
+		ResolveRootEnv()
+	}
+
+	out := buf.String()
+	if n := strings.Count(out, "WARNING:"); n != 1 {
+		t.Fatalf("expected exactly 1 warning, got %d: %q", n, out)
+	}
+	if !strings.Contains(out, EnvTownRootLegacy) || !strings.Contains(out, EnvRoot) {
+		t.Errorf("warning should name both variables: %q", out)
+	}
+	if !strings.Contains(out, "TestResolveRootEnv_WarnsOncePerProcess") {
+		t.Errorf("warning should name the reading component: %q", out)
+	}
+}
+
+func TestResolveRootEnv_NoWarningWhenRootSet(t *testing.T) {
+	buf := captureRootEnvWarning(t)
+	t.Setenv(EnvRoot, "/new")
+	t.Setenv(EnvTownRootLegacy, "/old")
+
+	ResolveRootEnv()
+
+	if buf.Len() != 0 {
+		t.Errorf("expected no warning when GT_ROOT is set, got %q", buf.String())
+	}
+}
+
+func TestRootEnv_SetsBothNames(t *testing.T) {
+	t.Parallel()
+	env := RootEnv("/town")
+	assertEnv(t, env, EnvRoot, "/town")
+	assertEnv(t, env, EnvTownRootLegacy, "/town")
+
+	if env := RootEnv(""); env != nil {
+		t.Errorf("RootEnv(\"\") = %v, want nil", env)
+	}
+}
+
+func TestAgentEnv_SetsLegacyTownRoot(t *testing.T) {
+	t.Parallel()
+	env := AgentEnv(AgentEnvConfig{Role: "mayor", TownRoot: "/town"})
+	assertEnv(t, env, EnvRoot, "/town")
+	assertEnv(t, env, EnvTownRootLegacy, "/town")
+}
+
+func TestSetRootEnv(t *testing.T) {
+	t.Parallel()
+	var order []string
+	got := map[string]string{}
+	err := SetRootEnv(func(key, value string) error {
+		order = append(order, key)
+		got[key] = value
+		return nil
+	}, "/town")
+	if err != nil {
+		t.Fatalf("SetRootEnv() error = %v", err)
+	}
+	if len(order) != 2 || order[0] != EnvRoot || order[1] != EnvTownRootLegacy {
+		t.Errorf("write order = %v, want [%s %s]", order, EnvRoot, EnvTownRootLegacy)
+	}
+	assertEnv(t, got, EnvRoot, "/town")
+	assertEnv(t, got, EnvTownRootLegacy, "/town")
+
+	boom := errors.New("boom")
+	err = SetRootEnv(func(key, value string) error { return boom }, "/town")
+	if !errors.Is(err, boom) {
+		t.Errorf("SetRootEnv() error = %v, want wrapped %v", err, boom)
+	}
+}
+
+// TestNoDirectLegacyRootEnvReads guards the deprecation shim: every Go reader
+// of the town root must go through ResolveRootEnv so the legacy variable is
+// handled (and warned about) consistently.
+func TestNoDirectLegacyRootEnvReads(t *testing.T) {
+	t.Parallel()
+	repoRoot, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(repoRoot, "go.mod")); err != nil {
+		t.Skipf("repo root not found at %s: %v", repoRoot, err)
+	}
+
+	patterns := []*regexp.Regexp{
+		regexp.MustCompile(`os\.(Getenv|LookupEnv)\(\s*"GT_TOWN_ROOT"\s*\)`),
+		regexp.MustCompile(`"GT_TOWN_ROOT"\s*,\s*"GT_ROOT"`),
+	}
+	self := filepath.Join(repoRoot, "internal", "config", "root_env.go")
+
+	var offenders []string
+	err = filepath.WalkDir(repoRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			switch d.Name() {
+			case ".git", "vendor", "node_modules", "testdata":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") || path == self {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for i, line := range strings.Split(string(data), "\n") {
+			for _, re := range patterns {
+				if re.MatchString(line) {
+					rel, _ := filepath.Rel(repoRoot, path)
+					offenders = append(offenders, rel+":"+strconv.Itoa(i+1)+": "+strings.TrimSpace(line))
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walking source tree: %v", err)
+	}
+	if len(offenders) > 0 {
+		t.Errorf("direct reads of GT_TOWN_ROOT outside config.ResolveRootEnv:\n  %s",
+			strings.Join(offenders, "\n  "))
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/buildinfo"
 	"github.com/steveyegge/gastown/internal/scheduler/capacity"
 )
 
@@ -90,6 +91,9 @@ type TownSettings struct {
 	// Convoy configures convoy behavior settings.
 	Convoy *ConvoyConfig `json:"convoy,omitempty"`
 
+	// Quota configures rate-limit detection for gt quota and the quota_dog patrol.
+	Quota *QuotaConfig `json:"quota,omitempty"`
+
 	// CostTier tracks which cost tier preset was applied (informational).
 	// Actual model assignments live in RoleAgents and Agents.
 	// Values: "standard", "economy", "budget", or empty for custom configs.
@@ -321,6 +325,15 @@ type DaemonThresholds struct {
 	// before considered stuck (default "2h").
 	StaleWorkingTimeout string `json:"stale_working_timeout,omitempty"`
 
+	// LogCompression is the compression used for rotated Dolt logs:
+	// "gzip" (default) or "zstd".
+	LogCompression string `json:"log_compression,omitempty"`
+
+	// RigLogBudgets caps the bytes of rotated Dolt log archives kept in each
+	// rig's .beads/ directory, keyed by rig name. Unlisted rigs are unbounded
+	// beyond the normal rotation count.
+	RigLogBudgets map[string]int64 `json:"rig_log_budgets,omitempty"`
+
 	// MaxDogPoolSize is target dog pool size (default 4).
 	MaxDogPoolSize *int `json:"max_dog_pool_size,omitempty"`
 
@@ -477,6 +490,11 @@ type WitnessThresholds struct {
 	// DoneIntentRecentGrace is how recently a done-intent must have been created
 	// to be considered still in progress (default "30s").
 	DoneIntentRecentGrace string `json:"done_intent_recent_grace,omitempty"`
+
+	// ZombieGracePeriod is how long after its last heartbeat a polecat whose
+	// session appears dead is reported as pending instead of a zombie
+	// (default "0", disabled).
+	ZombieGracePeriod string `json:"zombie_grace_period,omitempty"`
 }
 
 // DefaultOperationalConfig returns an OperationalConfig with all defaults.
@@ -491,6 +509,14 @@ type ConvoyConfig struct {
 	NotifyOnComplete bool `json:"notify_on_complete,omitempty"`
 }
 
+// QuotaConfig configures rate-limit detection.
+type QuotaConfig struct {
+	// RateLimitPatternFile replaces the built-in rate-limit patterns with those
+	// in this file (JSONL or one pattern per line). Relative paths are resolved
+	// against the town root. The file is reloaded when it changes.
+	RateLimitPatternFile string `json:"rate_limit_pattern_file,omitempty"`
+}
+
 // ParseDurationOrDefault parses a Go duration string, returning fallback on error or empty input.
 func ParseDurationOrDefault(s string, fallback time.Duration) time.Duration {
 	if s == "" {
@@ -1427,6 +1453,7 @@ type Account struct {
 	Email       string `json:"email"`                 // account email
 	Description string `json:"description,omitempty"` // human description
 	ConfigDir   string `json:"config_dir"`            // path to CLAUDE_CONFIG_DIR
+	OrgID       string `json:"org_id,omitempty"`      // expected Anthropic organization UUID (optional)
 }
 
 // CurrentAccountsVersion is the current schema version for AccountsConfig.
@@ -1457,6 +1484,10 @@ type QuotaState struct {
 	// keychain entry — not the target's. SyncSwappedTokens uses this map
 	// to propagate fresh tokens to all target keychain entries.
 	ActiveSwaps map[string]string `json:"active_swaps,omitempty"` // targetConfigDir -> sourceAccountHandle
+
+	// Provenance records the gt binary and accounts config that last wrote
+	// this file.
+	Provenance *buildinfo.Provenance `json:"provenance,omitempty"`
 }
 
 // AccountQuotaStatus is the rate-limit status of an account.
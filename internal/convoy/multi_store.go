@@ -109,7 +109,7 @@ func (r *StoreResolver) storeForID(id string) string {
 		return ""
 	}
 
-	rigName := beads.GetRigNameForPrefix(r.townRoot, prefix)
+	rigName := beads.GetRigNameForID(r.townRoot, id)
 	if rigName == "" {
 		// Town-level prefix (e.g., "hq-") or unknown → use hq store
 		return "hq"
@@ -461,12 +461,12 @@ func rigForIssue(townRoot, issueID string) string {
 	if prefix == "" {
 		return ""
 	}
-	return beads.GetRigNameForPrefix(townRoot, prefix)
+	return beads.GetRigNameForID(townRoot, issueID)
 }
 
 // fetchCrossRigBeadStatus fetches fresh status for beads that live in other rigs.
-// Groups IDs by prefix, resolves each prefix to its rig directory via routes,
-// and runs `bd show --json <ids>` per rig. Pattern from batchFetchBeadInfoByIDs
+// Resolves each ID to its rig directory via routes, groups IDs by rig, and
+// runs `bd show --json <ids>` per rig. Pattern from batchFetchBeadInfoByIDs
 // in capacity_dispatch.go.
 func fetchCrossRigBeadStatus(townRoot string, ids []string) map[string]*beadsdk.Issue {
 	result := make(map[string]*beadsdk.Issue)
@@ -474,22 +474,23 @@ func fetchCrossRigBeadStatus(townRoot string, ids []string) map[string]*beadsdk.
 		return result
 	}
 
-	// Group IDs by prefix
-	byPrefix := make(map[string][]string)
-	for _, id := range ids {
-		prefix := beads.ExtractPrefix(id)
-		if prefix != "" {
-			byPrefix[prefix] = append(byPrefix[prefix], id)
-		}
+	// Group IDs by the rig their full ID routes to
+	routes, err := beads.NewRouteCacheFromDir(townRoot)
+	if err != nil {
+		return result
 	}
-
-	for prefix, prefixIDs := range byPrefix {
-		rigPath := beads.GetRigPathForPrefix(townRoot, prefix)
-		if rigPath == "" {
+	byRig := make(map[string][]string)
+	for _, id := range ids {
+		if beads.ExtractPrefix(id) == "" {
 			continue
 		}
+		if rigPath, ok := routes.GetRigPathForID(id); ok {
+			byRig[rigPath] = append(byRig[rigPath], id)
+		}
+	}
 
-		args := append([]string{"show", "--json"}, prefixIDs...)
+	for rigPath, rigIDs := range byRig {
+		args := append([]string{"show", "--json"}, rigIDs...)
 		cmd := exec.Command("bd", args...)
 		cmd.Dir = rigPath
 		out, err := cmd.Output()
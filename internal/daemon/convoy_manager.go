@@ -77,6 +77,10 @@ type ConvoyManager struct {
 
 	gtPath string
 
+	// routes caches routes.jsonl for prefix -> rig lookups. May be nil, in
+	// which case lookups read routes.jsonl each time. Set via SetRouteCache.
+	routes atomic.Pointer[beads.RouteCache]
+
 	// started guards against double-call of Start() which would spawn duplicate goroutines.
 	started atomic.Bool
 
@@ -142,6 +146,23 @@ func NewConvoyManager(townRoot string, logger func(format string, args ...interf
 	}
 }
 
+// SetRouteCache makes the manager resolve bead prefixes through c. The
+// caller is responsible for reloading c when routes.jsonl changes. Passing
+// nil goes back to reading routes.jsonl on each lookup. Safe to call while
+// the manager is running.
+func (m *ConvoyManager) SetRouteCache(c *beads.RouteCache) {
+	m.routes.Store(c)
+}
+
+// rigForID returns the rig that owns a bead ID, or "" if none.
+func (m *ConvoyManager) rigForID(id string) string {
+	if routes := m.routes.Load(); routes != nil {
+		rig, _ := routes.GetRigNameForID(id)
+		return rig
+	}
+	return beads.GetRigNameForID(m.townRoot, id)
+}
+
 // Start begins the convoy manager goroutines (event poll + stranded scan).
 // It is safe to call multiple times; subsequent calls are no-ops.
 func (m *ConvoyManager) Start() error {
@@ -512,7 +533,7 @@ func (m *ConvoyManager) feedFirstReady(c strandedConvoyInfo) {
 			continue
 		}
 
-		rig := beads.GetRigNameForPrefix(m.townRoot, prefix)
+		rig := m.rigForID(issueID)
 		if rig == "" {
 			m.logger("Convoy %s: no rig for %s (prefix %s), skipping", c.ID, issueID, prefix)
 			continue
@@ -63,6 +63,10 @@ type Daemon struct {
 	doltServer    *DoltServerManager
 	krcPruner     *KRCPruner
 
+	// stopRouteWatch stops the routes.jsonl watcher behind the convoy
+	// manager's route cache. Nil when routes are not being watched.
+	stopRouteWatch func()
+
 	// disabledPatrols is loaded from town settings (disabled_patrols field).
 	// Provides a simple way to disable individual patrol dogs without editing
 	// mayor/daemon.json. Checked by isPatrolActive alongside patrolConfig.
@@ -171,13 +175,17 @@ func New(config *Config) (*Daemon, error) {
 	if err := session.InitRegistry(config.TownRoot); err != nil {
 		logger.Printf("Warning: failed to initialize town registry: %v", err)
 	}
+	if err := loadRuntimePrefixes(config.TownRoot); err != nil {
+		logger.Printf("Warning: failed to load runtime prefixes: %v", err)
+	}
 
-	// Set GT_TOWN_ROOT in tmux global environment so run-shell subprocesses
-	// (e.g., gt cycle next/prev) can find the workspace even when CWD is $HOME.
+	// Set GT_ROOT (and legacy GT_TOWN_ROOT) in tmux global environment so
+	// run-shell subprocesses (e.g., gt cycle next/prev) can find the workspace
+	// even when CWD is $HOME.
 	// Non-fatal: tmux server may not be running yet — daemon creates sessions shortly.
 	t := tmux.NewTmux()
-	if err := t.SetGlobalEnvironment("GT_TOWN_ROOT", config.TownRoot); err != nil {
-		logger.Printf("Warning: failed to set GT_TOWN_ROOT in tmux global env: %v", err)
+	if err := setTownRootGlobalEnv(t, config.TownRoot); err != nil {
+		logger.Printf("Warning: failed to set town root in tmux global env: %v", err)
 	}
 
 	// Load patrol config from mayor/daemon.json, ensuring lifecycle defaults
@@ -316,6 +324,60 @@ func New(config *Config) (*Daemon, error) {
 	}, nil
 }
 
+// setTownRootGlobalEnv writes the town root into the tmux global environment
+// under both GT_ROOT and the legacy GT_TOWN_ROOT (see config.RootEnv).
+func setTownRootGlobalEnv(t *tmux.Tmux, townRoot string) error {
+	return config.SetRootEnv(t.SetGlobalEnvironment, townRoot)
+}
+
+// startRouteWatch gives the convoy manager a routes.jsonl cache that
+// beads.WatchRoutes reloads on change. If the cache can't be loaded or the
+// platform can't watch files, the manager keeps reading routes.jsonl per
+// lookup so it never acts on stale routes.
+func (d *Daemon) startRouteWatch() {
+	cache, err := beads.NewRouteCacheFromDir(d.config.TownRoot)
+	if err != nil {
+		d.logger.Printf("Warning: loading routes cache: %v", err)
+		return
+	}
+	reload := func() {
+		if err := cache.Reload(); err != nil {
+			d.logger.Printf("Warning: reloading routes cache: %v", err)
+		}
+	}
+	// Install the cache before arming the watch so an immediate onStop's
+	// SetRouteCache(nil) can't be overwritten afterwards.
+	d.convoyManager.SetRouteCache(cache)
+	stop, err := beads.WatchRoutes(d.config.TownRoot, reload, func(err error) {
+		d.logger.Printf("Routes watch stopped (%v); resolving routes per lookup", err)
+		d.convoyManager.SetRouteCache(nil)
+	})
+	if err != nil {
+		d.convoyManager.SetRouteCache(nil)
+		d.logger.Printf("Routes watch unavailable (%v); resolving routes per lookup", err)
+		return
+	}
+	// Reload once now that the watch is armed: an edit made between the
+	// initial load and WatchRoutes would otherwise never be seen.
+	reload()
+	d.stopRouteWatch = stop
+}
+
+// loadRuntimePrefixes registers the prefixes listed in
+// .runtime/prefixes.jsonl on top of the rigs.json-derived default registry.
+// It runs after every InitRegistry so a registry reload keeps them.
+func loadRuntimePrefixes(townRoot string) error {
+	r, err := session.LoadPrefixRegistry(session.PrefixRegistryPath(townRoot))
+	if err != nil {
+		return err
+	}
+	def := session.DefaultRegistry()
+	for _, entry := range r.ListPrefixes() {
+		def.Register(entry.Prefix, entry.Rig)
+	}
+	return nil
+}
+
 // Run starts the daemon main loop.
 func (d *Daemon) Run() (err error) {
 	pid := os.Getpid()
@@ -382,6 +444,16 @@ func (d *Daemon) Run() (err error) {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, daemonSignals()...)
 
+	// Rotate Dolt logs on SIGHUP (Unix convention) in addition to the
+	// size-based rotation on each heartbeat.
+	hupChan := make(chan os.Signal, 1)
+	if sigs := rotationSignals(); len(sigs) > 0 {
+		signal.Notify(hupChan, sigs...)
+		defer signal.Stop(hupChan)
+	}
+	rotationResults := StartRotationWatcher(d.config.TownRoot, hupChan)
+	defer StopRotationWatcher(rotationResults)
+
 	// Fixed recovery-focused heartbeat (no activity-based backoff)
 	// Normal wake is handled by feed subscription (bd activity --follow)
 	timer := time.NewTimer(d.recoveryHeartbeatInterval())
@@ -420,6 +492,7 @@ func (d *Daemon) Run() (err error) {
 		}
 	}
 	d.convoyManager = NewConvoyManager(d.config.TownRoot, d.logger.Printf, d.gtPath, 0, d.beadsStores, storeOpener, isRigParked)
+	d.startRouteWatch()
 	if err := d.convoyManager.Start(); err != nil {
 		d.logger.Printf("Warning: failed to start convoy manager: %v", err)
 	} else {
@@ -586,6 +659,13 @@ func (d *Daemon) Run() (err error) {
 		d.logger.Printf("Quota dog ticker started (interval %v)", interval)
 	}
 
+	// Quota runtime GC: quarantine unreadable quota state and prune expired
+	// entries once at startup, then daily, so a corrupted file can't make
+	// every later quota command fail.
+	d.runQuotaGC()
+	quotaGCTicker := time.NewTicker(quotaGCInterval)
+	defer quotaGCTicker.Stop()
+
 	// Note: PATCH-010 uses per-session hooks in deacon/manager.go (SetAutoRespawnHook).
 	// Global pane-died hooks don't fire reliably in tmux 3.2a, so we rely on the
 	// per-session approach which has been tested to work for continuous recovery.
@@ -618,6 +698,12 @@ func (d *Daemon) Run() (err error) {
 				return d.shutdown(state)
 			}
 
+		case result, ok := <-rotationResults:
+			if ok {
+				d.logger.Println("Received SIGHUP, rotated logs")
+				d.logRotationResult(result)
+			}
+
 		case <-doltHealthChan:
 			// Dedicated Dolt health check — fast crash detection independent
 			// of the 3-minute general heartbeat.
@@ -688,6 +774,11 @@ func (d *Daemon) Run() (err error) {
 				d.runMainBranchTests()
 			}
 
+		case <-quotaGCTicker.C:
+			if !d.isShutdownInProgress() {
+				d.runQuotaGC()
+			}
+
 		case <-quotaDogChan:
 			// Quota dog — scans for rate-limited sessions and automatically
 			// rotates credentials to available accounts via keychain swap.
@@ -746,6 +837,9 @@ func (d *Daemon) heartbeat(state *State) {
 	if err := session.InitRegistry(d.config.TownRoot); err != nil {
 		d.logger.Printf("Warning: failed to reload prefix registry: %v", err)
 	}
+	if err := loadRuntimePrefixes(d.config.TownRoot); err != nil {
+		d.logger.Printf("Warning: failed to reload runtime prefixes: %v", err)
+	}
 
 	// 0b. Kill ghost sessions left over from stale registry (default "gt" prefix).
 	d.killDefaultPrefixGhosts()
@@ -880,7 +974,11 @@ func (d *Daemon) heartbeat(state *State) {
 // the size threshold. Uses copytruncate which is safe for logs held open by
 // child processes. Runs every heartbeat but is cheap (just stat calls).
 func (d *Daemon) rotateOversizedLogs() {
-	result := RotateLogs(d.config.TownRoot)
+	d.logRotationResult(RotateLogs(d.config.TownRoot))
+}
+
+// logRotationResult logs the files rotated and any errors.
+func (d *Daemon) logRotationResult(result *RotateLogsResult) {
 	for _, path := range result.Rotated {
 		d.logger.Printf("log_rotation: rotated %s", path)
 	}
@@ -1915,6 +2013,11 @@ func (d *Daemon) shutdown(state *State) error { //nolint:unparam // error return
 		d.logger.Println("Feed curator stopped")
 	}
 
+	// Stop routes.jsonl watcher
+	if d.stopRouteWatch != nil {
+		d.stopRouteWatch()
+	}
+
 	// Stop convoy manager (also closes beads stores)
 	if d.convoyManager != nil {
 		d.convoyManager.Stop()
@@ -0,0 +1,65 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// ValidateDaemonEnv is the daemon's pre-flight check of its environment,
+// read through getenv (os.Getenv outside tests). GT_ROOT must name a town
+// (a directory with a mayor/ subdir) and PATH must contain the bd binary.
+// Without these the daemon would work against an empty path and create
+// stray directories. All problems found are returned together.
+func ValidateDaemonEnv(getenv func(string) string) error {
+	var errs []error
+
+	if root := getenv(config.EnvRoot); root == "" {
+		errs = append(errs, fmt.Errorf("%s is not set", config.EnvRoot))
+	} else if info, err := os.Stat(filepath.Join(root, "mayor")); err != nil || !info.IsDir() {
+		errs = append(errs, fmt.Errorf("%s=%s is not a Gas Town workspace (no mayor/ directory)", config.EnvRoot, root))
+	}
+
+	if !inPath("bd", getenv("PATH")) {
+		errs = append(errs, errors.New("bd not found in PATH"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Env returns the environment for a `gt daemon run` child started for
+// townRoot: the current environment with GT_ROOT (and the legacy
+// GT_TOWN_ROOT) set, so the child passes ValidateDaemonEnv.
+func Env(townRoot string) []string {
+	env := os.Environ()
+	for key, value := range config.RootEnv(townRoot) {
+		env = append(env, key+"="+value)
+	}
+	return env
+}
+
+// inPath reports whether an executable called name is in one of the
+// directories of pathList. Unlike exec.LookPath it does not consult the
+// process environment.
+func inPath(name, pathList string) bool {
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	for _, dir := range filepath.SplitList(pathList) {
+		if dir == "" {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil || info.IsDir() {
+			continue
+		}
+		if runtime.GOOS == "windows" || info.Mode()&0111 != 0 {
+			return true
+		}
+	}
+	return false
+}
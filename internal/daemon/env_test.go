@@ -0,0 +1,86 @@
+package daemon
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeEnv returns a getenv func backed by vars.
+func fakeEnv(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+// setupDaemonEnv creates a town with a mayor/ dir and a PATH dir holding an
+// executable bd stub.
+func setupDaemonEnv(t *testing.T) (townRoot, binDir string) {
+	t.Helper()
+	townRoot = t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	binDir = t.TempDir()
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return townRoot, binDir
+}
+
+func TestValidateDaemonEnv_Valid(t *testing.T) {
+	townRoot, binDir := setupDaemonEnv(t)
+	err := ValidateDaemonEnv(fakeEnv(map[string]string{
+		"GT_ROOT": townRoot,
+		"PATH":    "/nonexistent" + string(os.PathListSeparator) + binDir,
+	}))
+	if err != nil {
+		t.Fatalf("ValidateDaemonEnv: %v", err)
+	}
+}
+
+func TestValidateDaemonEnv_ReportsAllProblems(t *testing.T) {
+	err := ValidateDaemonEnv(fakeEnv(map[string]string{"PATH": t.TempDir()}))
+	if err == nil {
+		t.Fatal("expected error for empty environment")
+	}
+	for _, want := range []string{"GT_ROOT is not set", "bd not found in PATH"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q missing %q", err, want)
+		}
+	}
+}
+
+func TestValidateDaemonEnv_NotATown(t *testing.T) {
+	_, binDir := setupDaemonEnv(t)
+	notTown := t.TempDir()
+	err := ValidateDaemonEnv(fakeEnv(map[string]string{"GT_ROOT": notTown, "PATH": binDir}))
+	if err == nil || !strings.Contains(err.Error(), "no mayor/ directory") {
+		t.Fatalf("error = %v, want missing mayor/ error", err)
+	}
+}
+
+func TestValidateDaemonEnv_NonExecutableBd(t *testing.T) {
+	townRoot, binDir := setupDaemonEnv(t)
+	if err := os.Chmod(filepath.Join(binDir, "bd"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err := ValidateDaemonEnv(fakeEnv(map[string]string{"GT_ROOT": townRoot, "PATH": binDir}))
+	if err == nil || !strings.Contains(err.Error(), "bd not found in PATH") {
+		t.Fatalf("error = %v, want bd not found", err)
+	}
+}
+
+func TestEnv_SetsRoot(t *testing.T) {
+	t.Setenv("GT_ROOT", "/somewhere/else")
+	env := Env("/town")
+	// exec uses the last value for duplicate keys.
+	var last string
+	for _, kv := range env {
+		if v, ok := strings.CutPrefix(kv, "GT_ROOT="); ok {
+			last = v
+		}
+	}
+	if last != "/town" {
+		t.Errorf("GT_ROOT = %q, want /town", last)
+	}
+}
@@ -9,7 +9,12 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/steveyegge/gastown/internal/config"
 )
 
 const (
@@ -29,28 +34,92 @@ const (
 	daemonDiskBudget int64 = 500 * 1024 * 1024 // 500MB
 )
 
+// Compression algorithms for rotated logs.
+const (
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+// RotationConfig controls how logs are rotated.
+type RotationConfig struct {
+	// CompressionAlgo is CompressionGzip (default) or CompressionZstd.
+	// Rotations are named <log>.N.gz or <log>.N.zst accordingly.
+	CompressionAlgo string
+
+	// RigBudget caps the bytes of rotated archives kept in each rig's
+	// .beads/ directory, keyed by rig name. Rigs without an entry are not
+	// trimmed beyond the normal maxBackups rotation limit.
+	RigBudget map[string]int64
+}
+
+// LoadRotationConfig reads rotation settings from the town's operational
+// daemon config (settings/config.json). An unknown algorithm falls back to
+// gzip and is reported in the returned error; cfg is usable either way.
+func LoadRotationConfig(townRoot string) (RotationConfig, error) {
+	cfg := RotationConfig{CompressionAlgo: CompressionGzip}
+	daemonCfg := config.LoadOperationalConfig(townRoot).GetDaemonConfig()
+	cfg.RigBudget = daemonCfg.RigLogBudgets
+	switch daemonCfg.LogCompression {
+	case "", CompressionGzip:
+	case CompressionZstd:
+		cfg.CompressionAlgo = CompressionZstd
+	default:
+		return cfg, fmt.Errorf("unknown log_compression %q, using %s", daemonCfg.LogCompression, CompressionGzip)
+	}
+	return cfg, nil
+}
+
+// rotationExts are the extensions of rotated archives for every supported
+// algorithm. Shifting and pruning cover all of them so that switching
+// log_compression does not strand archives written under the old setting.
+var rotationExts = []string{".gz", ".zst"}
+
+// archiveExt returns the rotated-file extension for algo.
+func archiveExt(algo string) string {
+	if algo == CompressionZstd {
+		return ".zst"
+	}
+	return ".gz"
+}
+
 // staleArchivePattern matches timestamped archive files like dolt-2026-02-28T23-19-42.log.gz
 var staleArchivePattern = regexp.MustCompile(`^.+-\d{4}-\d{2}-\d{2}T\d{2}-\d{2}-\d{2}\.log\.gz$`)
 
 // RotateLogsResult holds the result of a log rotation run.
 type RotateLogsResult struct {
-	Rotated []string // Log files that were rotated
-	Skipped []string // Log files that were too small
-	Errors  []error  // Non-fatal errors
+	Rotated         []string       // Log files that were rotated
+	Skipped         []string       // Log files that were too small
+	Errors          []error        // Non-fatal errors
+	RotatedBytes    int64          // Total size of rotated logs before truncation
+	CompressedBytes int64          // Total size of the .1.gz archives written
+	Cleanup         *CleanupResult // Archive cleanup run after rotation (nil if none)
 }
 
 // CleanupResult holds the result of archive cleanup operations.
 type CleanupResult struct {
 	StaleRemoved  []string // Stale timestamped archives deleted
 	BudgetRemoved []string // Files deleted to meet disk budget
+	BudgetSaved   int64    // Bytes freed by all deletions
 	Errors        []error  // Non-fatal errors
 }
 
+// rotationMu serializes rotation and cleanup within the process. The
+// heartbeat and the SIGHUP watcher both rotate the same files, and two
+// concurrent copytruncate passes would race on the renames and truncation.
+var rotationMu sync.Mutex
+
 // RotateLogs rotates all daemon-managed log files using copytruncate.
 // This is safe for Dolt server logs where the child process holds an open fd.
 // daemon.log is handled by lumberjack and is skipped here.
 func RotateLogs(townRoot string) *RotateLogsResult {
+	rotationMu.Lock()
+	defer rotationMu.Unlock()
+
 	result := &RotateLogsResult{}
+	cfg, err := LoadRotationConfig(townRoot)
+	if err != nil {
+		result.Errors = append(result.Errors, err)
+	}
 	daemonDir := filepath.Join(townRoot, "daemon")
 
 	// Collect all log files to rotate (excludes daemon.log which uses lumberjack)
@@ -70,22 +139,25 @@ func RotateLogs(townRoot string) *RotateLogsResult {
 			continue
 		}
 
-		if err := copyTruncateRotate(logPath); err != nil {
-			result.Errors = append(result.Errors, fmt.Errorf("rotating %s: %w", logPath, err))
-		} else {
-			result.Rotated = append(result.Rotated, logPath)
-		}
+		result.rotate(logPath, cfg)
 	}
 
 	// Clean stale archives and enforce disk budget after rotation
-	CleanDaemonDir(townRoot)
+	result.Cleanup = cleanDaemonDir(townRoot)
 
 	return result
 }
 
 // ForceRotateLogs rotates all daemon-managed log files regardless of size.
 func ForceRotateLogs(townRoot string) *RotateLogsResult {
+	rotationMu.Lock()
+	defer rotationMu.Unlock()
+
 	result := &RotateLogsResult{}
+	cfg, err := LoadRotationConfig(townRoot)
+	if err != nil {
+		result.Errors = append(result.Errors, err)
+	}
 	daemonDir := filepath.Join(townRoot, "daemon")
 
 	logFiles := collectDoltLogFiles(daemonDir, townRoot)
@@ -104,16 +176,117 @@ func ForceRotateLogs(townRoot string) *RotateLogsResult {
 			continue
 		}
 
-		if err := copyTruncateRotate(logPath); err != nil {
-			result.Errors = append(result.Errors, fmt.Errorf("rotating %s: %w", logPath, err))
-		} else {
-			result.Rotated = append(result.Rotated, logPath)
-		}
+		result.rotate(logPath, cfg)
 	}
 
 	return result
 }
 
+// ForceRotateRigLogs rotates a single rig's Dolt server logs
+// (<rig>/.beads/dolt-server.log and <rig>/rig/.beads/dolt-server.log)
+// regardless of size. Daemon-level logs and other rigs are not touched.
+// An invalid rig name, or one with no .beads directory, is an error.
+func ForceRotateRigLogs(townRoot, rigName string) (*RotateLogsResult, error) {
+	if rigName == "" || rigName == "." || rigName == ".." ||
+		strings.ContainsAny(rigName, `/\`) || strings.Contains(rigName, "..") {
+		return nil, fmt.Errorf("invalid rig name %q", rigName)
+	}
+	beadsDirs := rigBeadsDirs(townRoot, rigName)
+	if len(beadsDirs) == 0 {
+		return nil, fmt.Errorf("unknown rig %q: no .beads directory under %s", rigName, filepath.Join(townRoot, rigName))
+	}
+
+	rotationMu.Lock()
+	defer rotationMu.Unlock()
+
+	result := &RotateLogsResult{}
+	cfg, err := LoadRotationConfig(townRoot)
+	if err != nil {
+		result.Errors = append(result.Errors, err)
+	}
+	for _, beadsDir := range beadsDirs {
+		logPath := filepath.Join(beadsDir, "dolt-server.log")
+		info, err := os.Stat(logPath)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				result.Errors = append(result.Errors, fmt.Errorf("stat %s: %w", logPath, err))
+			}
+			continue
+		}
+		if info.Size() == 0 {
+			result.Skipped = append(result.Skipped, logPath)
+			continue
+		}
+		result.rotate(logPath, cfg)
+	}
+	return result, nil
+}
+
+// rotate copytruncate-rotates logPath and records the outcome.
+func (r *RotateLogsResult) rotate(logPath string, cfg RotationConfig) {
+	rotated, compressed, err := copyTruncateRotate(logPath, cfg.CompressionAlgo)
+	if err != nil {
+		r.Errors = append(r.Errors, fmt.Errorf("rotating %s: %w", logPath, err))
+		return
+	}
+	r.Rotated = append(r.Rotated, logPath)
+	r.RotatedBytes += rotated
+	r.CompressedBytes += compressed
+}
+
+// rotationWatchers maps each watcher's result channel to its stop channel.
+var (
+	rotationWatchersMu sync.Mutex
+	rotationWatchers   = map[<-chan *RotateLogsResult]chan struct{}{}
+)
+
+// StartRotationWatcher runs RotateLogs(townRoot) each time a signal arrives
+// on sigs, following the Unix convention of rotating logs on SIGHUP. It
+// returns immediately; results are delivered on the returned channel, which
+// is closed when the watcher stops (via StopRotationWatcher or when sigs is
+// closed). The caller owns sigs, including any signal.Notify registration.
+func StartRotationWatcher(townRoot string, sigs <-chan os.Signal) <-chan *RotateLogsResult {
+	results := make(chan *RotateLogsResult, 1)
+	stop := make(chan struct{})
+
+	rotationWatchersMu.Lock()
+	rotationWatchers[results] = stop
+	rotationWatchersMu.Unlock()
+
+	go func() {
+		defer close(results)
+		for {
+			select {
+			case <-stop:
+				return
+			case _, ok := <-sigs:
+				if !ok {
+					return
+				}
+				result := RotateLogs(townRoot)
+				select {
+				case results <- result:
+				case <-stop:
+					return
+				}
+			}
+		}
+	}()
+	return results
+}
+
+// StopRotationWatcher stops the watcher that returned results. The results
+// channel is closed once the watcher exits. Stopping an unknown or already
+// stopped watcher is a no-op.
+func StopRotationWatcher(results <-chan *RotateLogsResult) {
+	rotationWatchersMu.Lock()
+	defer rotationWatchersMu.Unlock()
+	if stop, ok := rotationWatchers[results]; ok {
+		close(stop)
+		delete(rotationWatchers, results)
+	}
+}
+
 // collectDoltLogFiles returns all Dolt-related log files that need copytruncate rotation.
 // Excludes daemon.log (handled by lumberjack).
 func collectDoltLogFiles(daemonDir, townRoot string) []string {
@@ -150,41 +323,139 @@ func collectDoltLogFiles(daemonDir, townRoot string) []string {
 	return logFiles
 }
 
+// LogEntry is one file in the log inventory: an active log or a rotated
+// archive.
+type LogEntry struct {
+	Name        string    `json:"name"`
+	Path        string    `json:"path"`
+	Rig         string    `json:"rig,omitempty"` // Empty for daemon/ logs
+	Size        int64     `json:"size"`
+	ModTime     time.Time `json:"mod_time"`
+	IsRotated   bool      `json:"is_rotated"`
+	Compression string    `json:"compression,omitempty"` // CompressionGzip, CompressionZstd or empty
+}
+
+// LogInventory lists the log files managed by rotation: the .log files and
+// their .gz/.zst archives under daemon/, and each rig's .beads/dolt-server.log
+// with its rotations. Entries are sorted by path.
+func LogInventory(townRoot string) ([]LogEntry, error) {
+	daemonDir := filepath.Join(townRoot, "daemon")
+	entries, err := logEntriesIn(daemonDir, "", func(name string) bool {
+		return strings.Contains(name, ".log")
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	isRigLog := func(name string) bool { return strings.HasPrefix(name, "dolt-server.log") }
+	for _, rig := range discoverRigBeadsDirs(townRoot) {
+		for _, beadsDir := range rigBeadsDirs(townRoot, rig) {
+			rigEntries, err := logEntriesIn(beadsDir, rig, isRigLog)
+			if err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+			entries = append(entries, rigEntries...)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	return entries, nil
+}
+
+// logEntriesIn returns the log files in dir whose names satisfy match and
+// end in .log, .gz or .zst.
+func logEntriesIn(dir, rig string, match func(name string) bool) ([]LogEntry, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var entries []LogEntry
+	for _, de := range dirEntries {
+		name := de.Name()
+		if de.IsDir() || !match(name) {
+			continue
+		}
+		entry := LogEntry{Name: name, Path: filepath.Join(dir, name), Rig: rig}
+		switch {
+		case strings.HasSuffix(name, ".log"):
+		case strings.HasSuffix(name, ".gz"):
+			entry.IsRotated, entry.Compression = true, CompressionGzip
+		case strings.HasSuffix(name, ".zst"):
+			entry.IsRotated, entry.Compression = true, CompressionZstd
+		default:
+			continue
+		}
+		info, err := de.Info()
+		if err != nil {
+			continue
+		}
+		entry.Size = info.Size()
+		entry.ModTime = info.ModTime()
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
 // copyTruncateRotate performs a safe copytruncate rotation:
-// 1. Copy current log to .1.gz (compressed)
+// 1. Copy current log to .1.gz (or .1.zst for zstd)
 // 2. Truncate the original file to 0 bytes
 // 3. Clean up old rotations beyond maxBackups
 //
 // This is safe for files held open by child processes (like Dolt server)
 // because the fd remains valid — only the file content is truncated.
-func copyTruncateRotate(logPath string) error {
-	// Shift existing rotations: .2.gz → .3.gz, .1.gz → .2.gz
-	for i := logRotationMaxBackups; i >= 1; i-- {
-		old := fmt.Sprintf("%s.%d.gz", logPath, i)
-		if i == logRotationMaxBackups {
-			// Remove the oldest
-			os.Remove(old)
-		} else {
-			next := fmt.Sprintf("%s.%d.gz", logPath, i+1)
-			_ = os.Rename(old, next)
+// Returns the size of the log just before truncation and of the new archive.
+func copyTruncateRotate(logPath, algo string) (rotatedBytes, compressedBytes int64, err error) {
+	ext := archiveExt(algo)
+
+	// Shift existing rotations of every algorithm: .2.gz → .3.gz,
+	// .1.gz → .2.gz, and likewise for .zst.
+	for _, e := range rotationExts {
+		for i := logRotationMaxBackups; i >= 1; i-- {
+			old := fmt.Sprintf("%s.%d%s", logPath, i, e)
+			if i == logRotationMaxBackups {
+				// Remove the oldest
+				os.Remove(old)
+			} else {
+				next := fmt.Sprintf("%s.%d%s", logPath, i+1, e)
+				_ = os.Rename(old, next)
+			}
 		}
 	}
 
 	// Copy current log to .1.gz
-	dst := logPath + ".1.gz"
-	if err := compressFile(logPath, dst); err != nil {
-		return fmt.Errorf("compressing to %s: %w", dst, err)
+	dst := logPath + ".1" + ext
+	compress := compressFile
+	if algo == CompressionZstd {
+		compress = compressFileZstd
+	}
+	if err := compress(logPath, dst); err != nil {
+		return 0, 0, fmt.Errorf("compressing to %s: %w", dst, err)
+	}
+	// Read the archive back before truncating: a short write or
+	// corrupt block would otherwise lose the log for good.
+	if err := verifyArchive(dst, algo); err != nil {
+		os.Remove(dst)
+		return 0, 0, fmt.Errorf("verifying %s: %w", dst, err)
+	}
+	if info, err := os.Stat(dst); err == nil {
+		compressedBytes = info.Size()
+	}
+
+	// Stat right before truncating: anything appended since the copy is
+	// also reclaimed by the truncate.
+	if info, err := os.Stat(logPath); err == nil {
+		rotatedBytes = info.Size()
 	}
 
 	// Truncate original (keeps fd valid for child processes)
 	if err := os.Truncate(logPath, 0); err != nil {
-		return fmt.Errorf("truncating %s: %w", logPath, err)
+		return 0, 0, fmt.Errorf("truncating %s: %w", logPath, err)
 	}
 
 	// Clean up any extra old rotations
 	cleanOldRotations(logPath)
 
-	return nil
+	return rotatedBytes, compressedBytes, nil
 }
 
 // compressFile copies src to dst with gzip compression.
@@ -210,31 +481,119 @@ func compressFile(src, dst string) error {
 	return err
 }
 
+// verifyArchive decompresses path to EOF with the decoder for algo. That
+// makes gzip check its trailer CRC-32 and length, and zstd its frame
+// checksum, against the data read.
+func verifyArchive(path, algo string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader
+	if algo == CompressionZstd {
+		dec, err := zstd.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer dec.Close()
+		r = dec
+	} else {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	_, err = io.Copy(io.Discard, r)
+	return err
+}
+
+// compressFileZstd copies src to dst with zstd compression.
+func compressFileZstd(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	enc, err := zstd.NewWriter(out)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(enc, in)
+	if closeErr := enc.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	return err
+}
+
 // CleanDaemonDir runs stale archive cleanup and disk budget enforcement.
 // Called from RotateLogs after normal rotation, and can be called independently.
 func CleanDaemonDir(townRoot string) *CleanupResult {
+	rotationMu.Lock()
+	defer rotationMu.Unlock()
+	return cleanDaemonDir(townRoot)
+}
+
+// cleanDaemonDir is CleanDaemonDir without locking; callers hold rotationMu.
+func cleanDaemonDir(townRoot string) *CleanupResult {
 	daemonDir := filepath.Join(townRoot, "daemon")
 	result := &CleanupResult{}
 
 	// Phase 1: Remove stale timestamped archives (older than 7 days)
-	stale, errs := cleanStaleArchives(daemonDir)
+	stale, freed, errs := cleanStaleArchives(daemonDir)
 	result.StaleRemoved = stale
+	result.BudgetSaved += freed
 	result.Errors = append(result.Errors, errs...)
 
 	// Phase 2: Enforce disk budget (delete oldest .gz files until under 500MB)
-	budgetRemoved, errs := enforceDiskBudget(daemonDir)
+	budgetRemoved, freed, errs := enforceDiskBudget(daemonDir)
 	result.BudgetRemoved = budgetRemoved
+	result.BudgetSaved += freed
 	result.Errors = append(result.Errors, errs...)
 
+	// Phase 3: Enforce per-rig budgets on rotated Dolt log archives in the
+	// rig's .beads/ directories
+	rotationCfg, _ := LoadRotationConfig(townRoot) // algo errors are reported by rotation
+	rigBudgets := rotationCfg.RigBudget
+	for _, rigName := range discoverRigBeadsDirs(townRoot) {
+		budget, ok := rigBudgets[rigName]
+		if !ok || budget <= 0 {
+			continue
+		}
+		removed, freed, errs := enforceRigBudget(rigBeadsDirs(townRoot, rigName), budget)
+		result.BudgetRemoved = append(result.BudgetRemoved, removed...)
+		result.BudgetSaved += freed
+		result.Errors = append(result.Errors, errs...)
+	}
+
 	return result
 }
 
+// DaemonDirUsage reports the bytes used by files in <townRoot>/daemon/ and
+// the budget CleanDaemonDir enforces on it. It does not modify anything.
+func DaemonDirUsage(townRoot string) (used, budget int64, err error) {
+	used, _, err = collectGzFiles(filepath.Join(townRoot, "daemon"))
+	return used, daemonDiskBudget, err
+}
+
 // cleanStaleArchives removes timestamped archive files older than staleArchiveMaxAge.
 // These are files like dolt-2026-02-28T23-19-42.log.gz created by manual/one-time archiving.
-func cleanStaleArchives(daemonDir string) (removed []string, errs []error) {
+func cleanStaleArchives(daemonDir string) (removed []string, freed int64, errs []error) {
 	entries, err := os.ReadDir(daemonDir)
 	if err != nil {
-		return nil, []error{fmt.Errorf("reading daemon dir: %w", err)}
+		return nil, 0, []error{fmt.Errorf("reading daemon dir: %w", err)}
 	}
 
 	cutoff := time.Now().Add(-staleArchiveMaxAge)
@@ -253,21 +612,22 @@ func cleanStaleArchives(daemonDir string) (removed []string, errs []error) {
 				errs = append(errs, fmt.Errorf("removing stale archive %s: %w", entry.Name(), err))
 			} else {
 				removed = append(removed, path)
+				freed += info.Size()
 			}
 		}
 	}
-	return removed, errs
+	return removed, freed, errs
 }
 
-// enforceDiskBudget deletes oldest .gz files in daemon/ until total size is under daemonDiskBudget.
-func enforceDiskBudget(daemonDir string) (removed []string, errs []error) {
+// enforceDiskBudget deletes oldest .gz/.zst files in daemon/ until total size is under daemonDiskBudget.
+func enforceDiskBudget(daemonDir string) (removed []string, freed int64, errs []error) {
 	totalSize, gzFiles, err := collectGzFiles(daemonDir)
 	if err != nil {
-		return nil, []error{fmt.Errorf("collecting gz files: %w", err)}
+		return nil, 0, []error{fmt.Errorf("collecting gz files: %w", err)}
 	}
 
 	if totalSize <= daemonDiskBudget {
-		return nil, nil
+		return nil, 0, nil
 	}
 
 	// Sort by modification time, oldest first
@@ -284,9 +644,101 @@ func enforceDiskBudget(daemonDir string) (removed []string, errs []error) {
 			continue
 		}
 		totalSize -= gf.size
+		freed += gf.size
 		removed = append(removed, gf.path)
 	}
-	return removed, errs
+	return removed, freed, errs
+}
+
+// rotatedDoltLogPattern matches the rig Dolt log rotations written by
+// copyTruncateRotate, e.g. dolt-server.log.2.gz or dolt-server.log.1.zst.
+var rotatedDoltLogPattern = regexp.MustCompile(`^dolt-server\.log\.\d+\.(gz|zst)$`)
+
+// enforceRigBudget deletes the oldest rotated Dolt log archives across a
+// rig's .beads/ directories until their total size is under budget. Only
+// those archives count toward the budget; the live database and any other
+// compressed files are never touched.
+func enforceRigBudget(beadsDirs []string, budget int64) (removed []string, freed int64, errs []error) {
+	var archives []gzFileInfo
+	var archiveSize int64
+	for _, dir := range beadsDirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reading %s: %w", dir, err))
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !rotatedDoltLogPattern.MatchString(entry.Name()) {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			archives = append(archives, gzFileInfo{
+				path:    filepath.Join(dir, entry.Name()),
+				size:    info.Size(),
+				modTime: info.ModTime(),
+			})
+			archiveSize += info.Size()
+		}
+	}
+	if archiveSize <= budget {
+		return nil, 0, errs
+	}
+
+	sort.Slice(archives, func(i, j int) bool {
+		return archives[i].modTime.Before(archives[j].modTime)
+	})
+
+	for _, gf := range archives {
+		if archiveSize <= budget {
+			break
+		}
+		if err := os.Remove(gf.path); err != nil {
+			errs = append(errs, fmt.Errorf("removing %s for rig budget: %w", gf.path, err))
+			continue
+		}
+		archiveSize -= gf.size
+		freed += gf.size
+		removed = append(removed, gf.path)
+	}
+	return removed, freed, errs
+}
+
+// discoverRigBeadsDirs returns the names of top-level town directories that
+// have a .beads/ directory, either directly or in the mayor clone at
+// <rig>/rig/.beads (rigs).
+func discoverRigBeadsDirs(townRoot string) []string {
+	entries, err := os.ReadDir(townRoot)
+	if err != nil {
+		return nil
+	}
+	var rigs []string
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || entry.Name() == "daemon" {
+			continue
+		}
+		if len(rigBeadsDirs(townRoot, entry.Name())) > 0 {
+			rigs = append(rigs, entry.Name())
+		}
+	}
+	return rigs
+}
+
+// rigBeadsDirs returns the existing .beads directories of rigName: the
+// rig-level <rig>/.beads and the mayor clone's <rig>/rig/.beads.
+func rigBeadsDirs(townRoot, rigName string) []string {
+	var dirs []string
+	for _, dir := range []string{
+		filepath.Join(townRoot, rigName, ".beads"),
+		filepath.Join(townRoot, rigName, "rig", ".beads"),
+	} {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
 }
 
 type gzFileInfo struct {
@@ -295,7 +747,8 @@ type gzFileInfo struct {
 	modTime time.Time
 }
 
-// collectGzFiles returns the total size of daemon/ and a list of .gz files with metadata.
+// collectGzFiles returns the total size of daemon/ and a list of compressed
+// archives (.gz and .zst) with metadata.
 func collectGzFiles(daemonDir string) (totalSize int64, gzFiles []gzFileInfo, err error) {
 	entries, err := os.ReadDir(daemonDir)
 	if err != nil {
@@ -311,7 +764,7 @@ func collectGzFiles(daemonDir string) (totalSize int64, gzFiles []gzFileInfo, er
 			continue
 		}
 		totalSize += info.Size()
-		if strings.HasSuffix(entry.Name(), ".gz") {
+		if strings.HasSuffix(entry.Name(), ".gz") || strings.HasSuffix(entry.Name(), ".zst") {
 			gzFiles = append(gzFiles, gzFileInfo{
 				path:    filepath.Join(daemonDir, entry.Name()),
 				size:    info.Size(),
@@ -322,14 +775,18 @@ func collectGzFiles(daemonDir string) (totalSize int64, gzFiles []gzFileInfo, er
 	return totalSize, gzFiles, nil
 }
 
-// cleanOldRotations removes rotations beyond maxBackups.
+// cleanOldRotations removes rotations beyond maxBackups, counting archives
+// of every algorithm together and keeping the newest.
 func cleanOldRotations(logPath string) {
-	dir := filepath.Dir(logPath)
-	base := filepath.Base(logPath)
-	pattern := base + ".*.gz"
-
-	matches, err := filepath.Glob(filepath.Join(dir, pattern))
-	if err != nil || len(matches) <= logRotationMaxBackups {
+	var matches []string
+	for _, ext := range rotationExts {
+		m, err := filepath.Glob(logPath + ".*" + ext)
+		if err != nil {
+			return
+		}
+		matches = append(matches, m...)
+	}
+	if len(matches) <= logRotationMaxBackups {
 		return
 	}
 
@@ -1,10 +1,18 @@
 package daemon
 
 import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"syscall"
 	"testing"
 	"time"
+
+	"github.com/klauspost/compress/zstd"
 )
 
 func TestCopyTruncateRotate(t *testing.T) {
@@ -18,7 +26,7 @@ func TestCopyTruncateRotate(t *testing.T) {
 	}
 
 	// Rotate it
-	if err := copyTruncateRotate(logPath); err != nil {
+	if _, _, err := copyTruncateRotate(logPath, CompressionGzip); err != nil {
 		t.Fatalf("copyTruncateRotate: %v", err)
 	}
 
@@ -38,6 +46,295 @@ func TestCopyTruncateRotate(t *testing.T) {
 	}
 }
 
+func TestCopyTruncateRotate_ReportsSizes(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "dolt.log")
+
+	// 1MB of repetitive log lines compresses well.
+	line := []byte("2026-03-01T12:00:00Z INFO server: query completed in 3ms\n")
+	data := bytes.Repeat(line, (1<<20)/len(line)+1)[:1<<20]
+	if err := os.WriteFile(logPath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, compressed, err := copyTruncateRotate(logPath, CompressionGzip)
+	if err != nil {
+		t.Fatalf("copyTruncateRotate: %v", err)
+	}
+	if rotated != 1<<20 {
+		t.Errorf("rotated = %d, want %d", rotated, 1<<20)
+	}
+	info, err := os.Stat(logPath + ".1.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if compressed != info.Size() {
+		t.Errorf("compressed = %d, want .1.gz size %d", compressed, info.Size())
+	}
+	if compressed <= 0 || compressed >= rotated {
+		t.Errorf("compressed = %d, want 0 < compressed < %d", compressed, rotated)
+	}
+
+	// The totals are accumulated on RotateLogsResult.
+	if err := os.WriteFile(logPath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	result := &RotateLogsResult{}
+	result.rotate(logPath, RotationConfig{})
+	if len(result.Rotated) != 1 || result.RotatedBytes != 1<<20 || result.CompressedBytes <= 0 {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestCopyTruncateRotate_CompressedIntegrity(t *testing.T) {
+	for _, algo := range []string{CompressionGzip, CompressionZstd} {
+		t.Run(algo, func(t *testing.T) {
+			dir := t.TempDir()
+			logPath := filepath.Join(dir, "dolt.log")
+			data := bytes.Repeat([]byte("2026-03-01T12:00:00Z INFO "+algo+" round trip\n"), 1000)
+			if err := os.WriteFile(logPath, data, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, _, err := copyTruncateRotate(logPath, algo); err != nil {
+				t.Fatalf("copyTruncateRotate: %v", err)
+			}
+
+			archive := logPath + ".1" + archiveExt(algo)
+			f, err := os.Open(archive)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			var r io.Reader
+			if algo == CompressionZstd {
+				dec, err := zstd.NewReader(f)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer dec.Close()
+				r = dec
+			} else {
+				gz, err := gzip.NewReader(f)
+				if err != nil {
+					t.Fatal(err)
+				}
+				r = gz
+			}
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("decompress: %v", err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Errorf("round trip mismatch: got %d bytes, want %d", len(got), len(data))
+			}
+
+			// A corrupted archive fails verification. Both formats end
+			// with a checksum: gzip's CRC-32 and length, zstd's frame hash.
+			raw, err := os.ReadFile(archive)
+			if err != nil {
+				t.Fatal(err)
+			}
+			raw[len(raw)-1] ^= 0xff
+			if err := os.WriteFile(archive, raw, 0600); err != nil {
+				t.Fatal(err)
+			}
+			if err := verifyArchive(archive, algo); err == nil {
+				t.Error("verifyArchive accepted an archive with a bad checksum")
+			}
+		})
+	}
+}
+
+func TestLogInventory(t *testing.T) {
+	townRoot := t.TempDir()
+	files := map[string]bool{ // relative path → want in inventory
+		"daemon/daemon.log":                      true,
+		"daemon/dolt.log":                        true,
+		"daemon/dolt.log.1.gz":                   true,
+		"daemon/dolt.log.2.zst":                  true,
+		"daemon/dolt-2026-02-28T23-19-42.log.gz": true,
+		"daemon/daemon.pid":                      false,
+		"gastown/.beads/dolt-server.log":         true,
+		"gastown/.beads/dolt-server.log.1.gz":    true,
+		"gastown/.beads/issues.jsonl":            false,
+		"beads/rig/.beads/dolt-server.log":       true,
+		"beads/.beads/config.yaml":               false,
+		".hidden/.beads/dolt-server.log":         false,
+	}
+	for rel := range files {
+		path := filepath.Join(townRoot, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("x"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := LogInventory(townRoot)
+	if err != nil {
+		t.Fatalf("LogInventory: %v", err)
+	}
+	got := make(map[string]LogEntry)
+	for _, e := range entries {
+		rel, _ := filepath.Rel(townRoot, e.Path)
+		got[filepath.ToSlash(rel)] = e
+	}
+	for rel, want := range files {
+		if _, ok := got[rel]; ok != want {
+			t.Errorf("%s in inventory = %v, want %v", rel, ok, want)
+		}
+	}
+	if len(got) != len(entries) {
+		t.Errorf("duplicate entries: %+v", entries)
+	}
+
+	if e := got["daemon/dolt.log"]; e.IsRotated || e.Compression != "" || e.Rig != "" || e.Size != 1 {
+		t.Errorf("daemon/dolt.log = %+v", e)
+	}
+	if e := got["daemon/dolt.log.2.zst"]; !e.IsRotated || e.Compression != CompressionZstd {
+		t.Errorf("daemon/dolt.log.2.zst = %+v", e)
+	}
+	if e := got["gastown/.beads/dolt-server.log.1.gz"]; !e.IsRotated || e.Compression != CompressionGzip || e.Rig != "gastown" {
+		t.Errorf("gastown/.beads/dolt-server.log.1.gz = %+v", e)
+	}
+	if e := got["beads/rig/.beads/dolt-server.log"]; e.Rig != "beads" || e.Name != "dolt-server.log" {
+		t.Errorf("beads/rig/.beads/dolt-server.log = %+v", e)
+	}
+}
+
+func TestCompressFileZstd(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "dolt.log")
+	dst := src + ".1.zst"
+	data := bytes.Repeat([]byte("2026-03-01T12:00:00Z INFO zstd round trip\n"), 1000)
+	if err := os.WriteFile(src, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := compressFileZstd(src, dst); err != nil {
+		t.Fatalf("compressFileZstd: %v", err)
+	}
+
+	f, err := os.Open(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	dec, err := zstd.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dec.Close()
+	got, err := io.ReadAll(dec)
+	if err != nil {
+		t.Fatalf("decompress: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("round trip mismatch: got %d bytes, want %d", len(got), len(data))
+	}
+}
+
+func TestForceRotateLogs_ZstdFromConfig(t *testing.T) {
+	townRoot := t.TempDir()
+	settings := filepath.Join(townRoot, "settings", "config.json")
+	if err := os.MkdirAll(filepath.Dir(settings), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(settings, []byte(`{"operational":{"daemon":{"log_compression":"zstd"}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if cfg, err := LoadRotationConfig(townRoot); err != nil || cfg.CompressionAlgo != CompressionZstd {
+		t.Fatalf("LoadRotationConfig = (%+v, %v), want zstd", cfg, err)
+	}
+
+	logPath := filepath.Join(townRoot, "daemon", "dolt.log")
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(logPath, []byte("data\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	result := ForceRotateLogs(townRoot)
+	if len(result.Errors) != 0 || len(result.Rotated) != 1 {
+		t.Fatalf("result = %+v", result)
+	}
+	if _, err := os.Stat(logPath + ".1.zst"); err != nil {
+		t.Errorf("expected .1.zst archive: %v", err)
+	}
+	if _, err := os.Stat(logPath + ".1.gz"); !os.IsNotExist(err) {
+		t.Errorf("did not expect a .1.gz archive")
+	}
+}
+
+func TestLoadRotationConfig_DefaultsToGzip(t *testing.T) {
+	if cfg, err := LoadRotationConfig(t.TempDir()); err != nil || cfg.CompressionAlgo != CompressionGzip {
+		t.Errorf("LoadRotationConfig = (%+v, %v), want gzip", cfg, err)
+	}
+}
+
+func TestLoadRotationConfig_UnknownAlgo(t *testing.T) {
+	townRoot := t.TempDir()
+	settings := filepath.Join(townRoot, "settings", "config.json")
+	if err := os.MkdirAll(filepath.Dir(settings), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(settings, []byte(`{"operational":{"daemon":{"log_compression":"lz4"}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadRotationConfig(townRoot)
+	if err == nil || !strings.Contains(err.Error(), "lz4") {
+		t.Errorf("err = %v, want unknown log_compression error", err)
+	}
+	if cfg.CompressionAlgo != CompressionGzip {
+		t.Errorf("CompressionAlgo = %q, want gzip fallback", cfg.CompressionAlgo)
+	}
+	if result := ForceRotateLogs(townRoot); len(result.Errors) != 1 {
+		t.Errorf("ForceRotateLogs errors = %v, want the config warning", result.Errors)
+	}
+}
+
+func TestCopyTruncateRotate_SwitchingAlgoPrunesOldArchives(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "dolt-server.log")
+
+	// Three gzip rotations written under the old setting, oldest first.
+	old := time.Now().Add(-time.Hour)
+	for i := logRotationMaxBackups; i >= 1; i-- {
+		gz := fmt.Sprintf("%s.%d.gz", logPath, i)
+		if err := os.WriteFile(gz, []byte("old"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		mtime := old.Add(time.Duration(-i) * time.Minute)
+		if err := os.Chtimes(gz, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.WriteFile(logPath, []byte("data\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := copyTruncateRotate(logPath, CompressionZstd); err != nil {
+		t.Fatal(err)
+	}
+
+	// The gzip archives were shifted and pruned together with the new
+	// zstd one: .1.zst plus the two newest .gz, now .2.gz and .3.gz.
+	for _, want := range []string{".1.zst", ".2.gz", ".3.gz"} {
+		if _, err := os.Stat(logPath + want); err != nil {
+			t.Errorf("expected %s: %v", want, err)
+		}
+	}
+	for _, gone := range []string{".1.gz", ".4.gz"} {
+		if _, err := os.Stat(logPath + gone); !os.IsNotExist(err) {
+			t.Errorf("%s should not exist", gone)
+		}
+	}
+}
+
 func TestCopyTruncateRotate_ShiftsBackups(t *testing.T) {
 	dir := t.TempDir()
 	logPath := filepath.Join(dir, "test.log")
@@ -47,7 +344,7 @@ func TestCopyTruncateRotate_ShiftsBackups(t *testing.T) {
 		if err := os.WriteFile(logPath, []byte("data\n"), 0600); err != nil {
 			t.Fatal(err)
 		}
-		if err := copyTruncateRotate(logPath); err != nil {
+		if _, _, err := copyTruncateRotate(logPath, CompressionGzip); err != nil {
 			t.Fatalf("rotation %d: %v", i, err)
 		}
 	}
@@ -128,6 +425,84 @@ func TestForceRotateLogs_SkipsEmptyFiles(t *testing.T) {
 	}
 }
 
+func TestForceRotateRigLogs_OnlyTargetsRig(t *testing.T) {
+	townRoot := t.TempDir()
+	daemonLog := filepath.Join(townRoot, "daemon", "dolt.log")
+	rigLogs := map[string]string{
+		"gastown": filepath.Join(townRoot, "gastown", ".beads", "dolt-server.log"),
+		"beads":   filepath.Join(townRoot, "beads", ".beads", "dolt-server.log"),
+	}
+	for _, p := range append([]string{daemonLog}, rigLogs["gastown"], rigLogs["beads"]) {
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte("log data\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := ForceRotateRigLogs(townRoot, "gastown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Errors) != 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	if len(result.Rotated) != 1 || result.Rotated[0] != rigLogs["gastown"] {
+		t.Errorf("Rotated = %v, want [%s]", result.Rotated, rigLogs["gastown"])
+	}
+	if _, err := os.Stat(rigLogs["gastown"] + ".1.gz"); err != nil {
+		t.Errorf("expected gastown archive: %v", err)
+	}
+	for _, untouched := range []string{daemonLog, rigLogs["beads"]} {
+		if _, err := os.Stat(untouched + ".1.gz"); !os.IsNotExist(err) {
+			t.Errorf("%s should not have been rotated", untouched)
+		}
+		if info, err := os.Stat(untouched); err != nil || info.Size() == 0 {
+			t.Errorf("%s should be untouched", untouched)
+		}
+	}
+}
+
+func TestForceRotateRigLogs_RejectsPathNames(t *testing.T) {
+	townRoot := t.TempDir()
+	for _, name := range []string{"", ".", "..", "../etc", "a/b", `a\b`, "x..y"} {
+		if _, err := ForceRotateRigLogs(townRoot, name); err == nil {
+			t.Errorf("ForceRotateRigLogs(%q) succeeded, want an error", name)
+		}
+	}
+	// A rig without a .beads directory is unknown.
+	if _, err := ForceRotateRigLogs(townRoot, "gastown"); err == nil || !strings.Contains(err.Error(), "unknown rig") {
+		t.Errorf("unknown rig: err = %v, want unknown rig error", err)
+	}
+	// A known rig with no log yet is not an error.
+	if err := os.MkdirAll(filepath.Join(townRoot, "gastown", ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if result, err := ForceRotateRigLogs(townRoot, "gastown"); err != nil || len(result.Errors) != 0 || len(result.Rotated) != 0 {
+		t.Errorf("missing log: got (%+v, %v)", result, err)
+	}
+}
+
+func TestForceRotateRigLogs_MayorClone(t *testing.T) {
+	townRoot := t.TempDir()
+	logPath := filepath.Join(townRoot, "gastown", "rig", ".beads", "dolt-server.log")
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(logPath, []byte("log data\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := ForceRotateRigLogs(townRoot, "gastown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Rotated) != 1 || result.Rotated[0] != logPath {
+		t.Errorf("Rotated = %v, want [%s]", result.Rotated, logPath)
+	}
+}
+
 func TestCleanStaleArchives_RemovesOldFiles(t *testing.T) {
 	daemonDir := t.TempDir()
 
@@ -157,7 +532,7 @@ func TestCleanStaleArchives_RemovesOldFiles(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	removed, errs := cleanStaleArchives(daemonDir)
+	removed, _, errs := cleanStaleArchives(daemonDir)
 	if len(errs) != 0 {
 		t.Errorf("unexpected errors: %v", errs)
 	}
@@ -191,7 +566,7 @@ func TestCleanStaleArchives_IgnoresNonTimestamped(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	removed, errs := cleanStaleArchives(daemonDir)
+	removed, _, errs := cleanStaleArchives(daemonDir)
 	if len(errs) != 0 {
 		t.Errorf("unexpected errors: %v", errs)
 	}
@@ -259,12 +634,12 @@ func TestEnforceDiskBudget_DeletesOldestFirst(t *testing.T) {
 	}
 
 	// Total is well under 500MB, so nothing should be removed
-	removed, errs := enforceDiskBudget(daemonDir)
+	removed, freed, errs := enforceDiskBudget(daemonDir)
 	if len(errs) != 0 {
 		t.Errorf("unexpected errors: %v", errs)
 	}
-	if len(removed) != 0 {
-		t.Errorf("expected no removals (under budget), got %v", removed)
+	if len(removed) != 0 || freed != 0 {
+		t.Errorf("expected no removals (under budget), got %v (%d bytes)", removed, freed)
 	}
 }
 
@@ -292,9 +667,142 @@ func TestCleanDaemonDir_Integration(t *testing.T) {
 	if len(result.StaleRemoved) != 1 {
 		t.Errorf("expected 1 stale removal, got %d", len(result.StaleRemoved))
 	}
+	if result.BudgetSaved != int64(len("stale")) {
+		t.Errorf("BudgetSaved = %d, want %d", result.BudgetSaved, len("stale"))
+	}
 
 	// Verify file is gone
 	if _, err := os.Stat(stalePath); !os.IsNotExist(err) {
 		t.Errorf("stale archive should have been deleted")
 	}
 }
+
+func TestCleanDaemonDir_RigBudget(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "daemon"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	settings := filepath.Join(townRoot, "settings", "config.json")
+	if err := os.MkdirAll(filepath.Dir(settings), 0755); err != nil {
+		t.Fatal(err)
+	}
+	// gastown may keep 250 bytes of archives; beads has no budget.
+	if err := os.WriteFile(settings, []byte(`{"operational":{"daemon":{"rig_log_budgets":{"gastown":250}}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	archive := make([]byte, 100)
+	write := func(beadsDir, name string, age time.Duration) string {
+		t.Helper()
+		path := filepath.Join(townRoot, beadsDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, archive, 0600); err != nil {
+			t.Fatal(err)
+		}
+		ts := time.Now().Add(-age)
+		if err := os.Chtimes(path, ts, ts); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	oldest := write("gastown/.beads", "dolt-server.log.3.gz", 3*time.Hour)
+	mid := write("gastown/.beads", "dolt-server.log.2.gz", 2*time.Hour)
+	// The mayor clone's archives count toward the same rig budget.
+	newest := write("gastown/rig/.beads", "dolt-server.log.1.zst", time.Hour)
+	// Compressed files that are not rotated Dolt logs are never removed.
+	backup := write("gastown/.beads", "backup.jsonl.gz", 4*time.Hour)
+	// The live log is not an archive and never counts toward the budget.
+	if err := os.WriteFile(filepath.Join(townRoot, "gastown", ".beads", "dolt-server.log"), make([]byte, 1000), 0600); err != nil {
+		t.Fatal(err)
+	}
+	var otherRig []string
+	for i, age := range []time.Duration{3 * time.Hour, 2 * time.Hour, time.Hour} {
+		otherRig = append(otherRig, write("beads/.beads", "dolt-server.log."+string(rune('1'+i))+".gz", age))
+	}
+
+	result := CleanDaemonDir(townRoot)
+	if len(result.Errors) != 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	if len(result.BudgetRemoved) != 1 || result.BudgetRemoved[0] != oldest {
+		t.Errorf("BudgetRemoved = %v, want [%s]", result.BudgetRemoved, oldest)
+	}
+	if result.BudgetSaved != 100 {
+		t.Errorf("BudgetSaved = %d, want 100", result.BudgetSaved)
+	}
+	for _, kept := range append([]string{mid, newest, backup}, otherRig...) {
+		if _, err := os.Stat(kept); err != nil {
+			t.Errorf("%s should have been kept: %v", kept, err)
+		}
+	}
+}
+
+func TestStartRotationWatcher_RotatesOnSignal(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "daemon"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	sigs := make(chan os.Signal, 1)
+	results := StartRotationWatcher(townRoot, sigs)
+	defer StopRotationWatcher(results)
+
+	sigs <- syscall.SIGHUP
+	select {
+	case result := <-results:
+		if result == nil {
+			t.Fatal("got nil result")
+		}
+		if len(result.Errors) != 0 {
+			t.Errorf("unexpected errors: %v", result.Errors)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no rotation result after signal")
+	}
+}
+
+func TestStartRotationWatcher_WaitsForInProgressRotation(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "daemon"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	sigs := make(chan os.Signal, 1)
+	results := StartRotationWatcher(townRoot, sigs)
+	defer StopRotationWatcher(results)
+
+	// Simulate a heartbeat rotation in progress: the signal-driven pass
+	// must not run until it finishes.
+	rotationMu.Lock()
+	sigs <- syscall.SIGHUP
+	select {
+	case <-results:
+		rotationMu.Unlock()
+		t.Fatal("rotation ran concurrently with an in-progress rotation")
+	case <-time.After(100 * time.Millisecond):
+	}
+	rotationMu.Unlock()
+
+	select {
+	case <-results:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no rotation result after the in-progress rotation finished")
+	}
+}
+
+func TestStopRotationWatcher_ClosesResults(t *testing.T) {
+	results := StartRotationWatcher(t.TempDir(), make(chan os.Signal))
+	StopRotationWatcher(results)
+	StopRotationWatcher(results) // second stop is a no-op
+
+	select {
+	case _, ok := <-results:
+		if ok {
+			t.Error("expected results channel to be closed without a value")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("results channel not closed after stop")
+	}
+}
@@ -3,14 +3,22 @@ package daemon
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"os/exec"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/quota"
 )
 
 const (
 	defaultQuotaDogInterval = 5 * time.Minute
 	// quotaDogTimeout is the maximum time allowed for a single rotation cycle.
 	quotaDogTimeout = 2 * time.Minute
+
+	// quotaGCInterval is how often quota runtime state is garbage collected.
+	quotaGCInterval = 24 * time.Hour
+	// quotaGCTimeout bounds a single `gt quota runtime gc` run.
+	quotaGCTimeout = 30 * time.Second
 )
 
 // QuotaDogConfig holds configuration for the quota_dog patrol.
@@ -76,3 +84,43 @@ func (d *Daemon) runQuotaDog() {
 		d.logger.Printf("quota_dog: no rate-limited sessions detected")
 	}
 }
+
+// runQuotaGC shells out to `gt quota runtime gc --json` to validate and prune
+// quota state files. It only runs while quota_dog is enabled, since that
+// patrol is what produces the state. Failures are logged and otherwise ignored.
+func (d *Daemon) runQuotaGC() {
+	if !d.isPatrolActive("quota_dog") {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(d.ctx, quotaGCTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, d.gtPath, "quota", "runtime", "gc", "--json") //nolint:gosec // G204: gtPath resolved at daemon init
+	cmd.Dir = d.config.TownRoot
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		d.logger.Printf("quota_gc: failed (non-fatal): %v: %s", err, bytes.TrimSpace(stderr.Bytes()))
+		return
+	}
+
+	var report quota.GCReport
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		d.logger.Printf("quota_gc: unreadable report (non-fatal): %v", err)
+		return
+	}
+	for _, f := range report.Files {
+		switch {
+		case f.Error != "":
+			d.logger.Printf("quota_gc: %s: %s", f.Name, f.Error)
+		case f.Quarantined != "":
+			d.logger.Printf("quota_gc: %s quarantined to %s (%s)", f.Name, f.Quarantined, f.Reason)
+		case f.Pruned > 0:
+			d.logger.Printf("quota_gc: %s: pruned %d expired entries", f.Name, f.Pruned)
+		}
+	}
+}
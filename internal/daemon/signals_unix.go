@@ -23,3 +23,8 @@ func isLifecycleSignal(sig os.Signal) bool {
 func isReloadRestartSignal(sig os.Signal) bool {
 	return sig == syscall.SIGUSR2
 }
+
+// rotationSignals are the signals that trigger log rotation.
+func rotationSignals() []os.Signal {
+	return []os.Signal{syscall.SIGHUP}
+}
@@ -21,3 +21,9 @@ func isLifecycleSignal(sig os.Signal) bool {
 func isReloadRestartSignal(sig os.Signal) bool {
 	return false
 }
+
+// rotationSignals are the signals that trigger log rotation. Windows has no
+// SIGHUP, so rotation is only size-based.
+func rotationSignals() []os.Signal {
+	return nil
+}
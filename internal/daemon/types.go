@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/buildinfo"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/util"
 )
@@ -61,6 +62,10 @@ type State struct {
 
 	// HeartbeatCount is how many heartbeats have completed.
 	HeartbeatCount int64 `json:"heartbeat_count"`
+
+	// Provenance records the gt binary and daemon config that last wrote
+	// this file.
+	Provenance *buildinfo.Provenance `json:"provenance,omitempty"`
 }
 
 // StateFile returns the path to the state file.
@@ -83,6 +88,7 @@ func LoadState(townRoot string) (*State, error) {
 	if err := json.Unmarshal(data, &state); err != nil {
 		return nil, err
 	}
+	buildinfo.NoteLoaded(stateFile, state.Provenance)
 	return &state, nil
 }
 
@@ -95,6 +101,7 @@ func SaveState(townRoot string, state *State) error {
 		return err
 	}
 
+	state.Provenance = buildinfo.Stamp(buildinfo.ConfigFingerprint(PatrolConfigFile(townRoot)))
 	return util.AtomicWriteJSON(stateFile, state)
 }
 
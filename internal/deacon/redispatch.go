@@ -326,7 +326,7 @@ func resolveRigFromBead(townRoot, beadID string) string {
 	if prefix == "" {
 		return ""
 	}
-	return beads.GetRigNameForPrefix(townRoot, prefix)
+	return beads.GetRigNameForID(townRoot, beadID)
 }
 
 // getBeadStatusForRedispatch returns the current status of a bead.
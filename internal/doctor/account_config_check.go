@@ -0,0 +1,119 @@
+package doctor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/quota"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// AccountConfigCheck verifies that mayor/accounts.json is valid (see
+// config.AccountsConfig.Validate) and that every account is usable for quota
+// rotation: its config dir exists and is logged in, and its organization is
+// known so identity mismatches can be detected.
+type AccountConfigCheck struct {
+	BaseCheck
+}
+
+// NewAccountConfigCheck creates a new account config check.
+func NewAccountConfigCheck() *AccountConfigCheck {
+	return &AccountConfigCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "account-config",
+			CheckDescription: "Verify Claude account config dirs and org IDs",
+			CheckCategory:    CategoryConfig,
+		},
+	}
+}
+
+// Run checks each configured account and reports the worst problem found.
+func (c *AccountConfigCheck) Run(ctx *CheckContext) *CheckResult {
+	// Prefer the accounts already loaded into the context; they still need
+	// the stricter validation that LoadAndValidate applies.
+	accounts := ctx.Accounts
+	var err error
+	if accounts != nil {
+		err = errors.Join(accounts.Validate()...)
+	} else {
+		accounts, err = config.LoadAndValidate(constants.MayorAccountsPath(ctx.TownRoot))
+	}
+	if errors.Is(err, config.ErrNotFound) {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No accounts configured",
+		}
+	}
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "Invalid accounts config",
+			Details: strings.Split(err.Error(), "\n"), // one line per validation error
+			FixHint: "Correct the listed entries in mayor/accounts.json",
+		}
+	}
+
+	handles := make([]string, 0, len(accounts.Accounts))
+	for handle := range accounts.Accounts {
+		handles = append(handles, handle)
+	}
+	sort.Strings(handles)
+
+	status := StatusOK
+	var details []string
+	var missingOrg bool
+	for _, handle := range handles {
+		acct := accounts.Accounts[handle]
+		configDir := util.ExpandHome(acct.ConfigDir)
+
+		if info, err := os.Stat(configDir); err != nil || !info.IsDir() {
+			status = StatusError
+			details = append(details, fmt.Sprintf("%s: config dir %s does not exist", handle, acct.ConfigDir))
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(configDir, ".claude.json")); err != nil {
+			status = max(status, StatusWarning)
+			details = append(details, fmt.Sprintf("%s: no .claude.json in %s (not logged in?)", handle, acct.ConfigDir))
+			continue
+		}
+		if acct.OrgID == "" {
+			if orgID, err := quota.ReadOrgID(configDir); err != nil || orgID == "" {
+				status = max(status, StatusWarning)
+				missingOrg = true
+				details = append(details, fmt.Sprintf("%s: no org ID configured or cached in .claude.json", handle))
+			}
+		} else if mismatch, err := quota.CheckAccountIdentity(acct, configDir); err == nil && mismatch != nil {
+			status = StatusError
+			details = append(details, fmt.Sprintf("%s: configured org %s but %s/.claude.json belongs to org %s",
+				handle, mismatch.ConfiguredOrgID, acct.ConfigDir, mismatch.ActualOrgID))
+		}
+	}
+
+	if status == StatusOK {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("All %d account(s) configured", len(handles)),
+		}
+	}
+
+	hint := "Create the missing config dirs or correct config_dir in mayor/accounts.json"
+	if missingOrg && status == StatusWarning {
+		hint = "Log in to each account, then set org_id from 'gt quota org-id-extract --all-accounts' (gt account add <handle> --org-id <uuid>)"
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  status,
+		Message: fmt.Sprintf("%d of %d account(s) incomplete", len(details), len(handles)),
+		Details: details,
+		FixHint: hint,
+	}
+}
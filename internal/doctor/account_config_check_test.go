@@ -0,0 +1,133 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// writeAccountDir creates a config dir, optionally with a .claude.json
+// carrying orgID (empty orgID writes a file without oauthAccount).
+func writeAccountDir(t *testing.T, dir string, withClaudeJSON bool, orgID string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if !withClaudeJSON {
+		return
+	}
+	data := `{}`
+	if orgID != "" {
+		data = `{"oauthAccount":{"organizationUuid":"` + orgID + `"}}`
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".claude.json"), []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func saveAccounts(t *testing.T, townRoot string, accounts map[string]config.Account) {
+	t.Helper()
+	cfg := &config.AccountsConfig{Version: config.CurrentAccountsVersion, Accounts: accounts}
+	if err := config.SaveAccountsConfig(constants.MayorAccountsPath(townRoot), cfg); err != nil {
+		t.Fatalf("SaveAccountsConfig: %v", err)
+	}
+}
+
+const testOrgID = "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0"
+
+func TestAccountConfigCheck(t *testing.T) {
+	townRoot := t.TempDir()
+	base := t.TempDir()
+
+	complete := filepath.Join(base, "complete")
+	writeAccountDir(t, complete, true, "")
+	derived := filepath.Join(base, "derived")
+	writeAccountDir(t, derived, true, "org-derived")
+	noOrg := filepath.Join(base, "no-org")
+	writeAccountDir(t, noOrg, true, "")
+	missing := filepath.Join(base, "missing")
+
+	t.Run("all complete", func(t *testing.T) {
+		saveAccounts(t, townRoot, map[string]config.Account{
+			"work":     {Email: "w@example.com", ConfigDir: complete, OrgID: testOrgID},
+			"personal": {Email: "p@example.com", ConfigDir: derived},
+		})
+		result := NewAccountConfigCheck().Run(&CheckContext{TownRoot: townRoot})
+		if result.Status != StatusOK {
+			t.Errorf("status = %v, want OK: %s %v", result.Status, result.Message, result.Details)
+		}
+	})
+
+	t.Run("missing org is a warning", func(t *testing.T) {
+		saveAccounts(t, townRoot, map[string]config.Account{
+			"work":  {Email: "w@example.com", ConfigDir: complete, OrgID: testOrgID},
+			"noorg": {Email: "n@example.com", ConfigDir: noOrg},
+		})
+		result := NewAccountConfigCheck().Run(&CheckContext{TownRoot: townRoot})
+		if result.Status != StatusWarning {
+			t.Errorf("status = %v, want warning: %s", result.Status, result.Message)
+		}
+		if len(result.Details) != 1 {
+			t.Errorf("details = %v, want one line for noorg", result.Details)
+		}
+	})
+
+	t.Run("missing config dir is an error", func(t *testing.T) {
+		saveAccounts(t, townRoot, map[string]config.Account{
+			"work":  {Email: "w@example.com", ConfigDir: complete, OrgID: testOrgID},
+			"noorg": {Email: "n@example.com", ConfigDir: noOrg},
+			"gone":  {Email: "g@example.com", ConfigDir: missing},
+		})
+		check := NewAccountConfigCheck()
+		result := check.Run(&CheckContext{TownRoot: townRoot})
+		if result.Status != StatusError {
+			t.Errorf("status = %v, want error (worst account): %s", result.Status, result.Message)
+		}
+		if len(result.Details) != 2 {
+			t.Errorf("details = %v, want lines for gone and noorg", result.Details)
+		}
+		if check.CanFix() {
+			t.Error("CanFix() = true, want false")
+		}
+	})
+
+	t.Run("configured org differs from credentials", func(t *testing.T) {
+		wrong := filepath.Join(base, "wrong-org")
+		writeAccountDir(t, wrong, true, "org-personal")
+		saveAccounts(t, townRoot, map[string]config.Account{
+			"work": {Email: "w@example.com", ConfigDir: wrong, OrgID: testOrgID},
+		})
+		result := NewAccountConfigCheck().Run(&CheckContext{TownRoot: townRoot})
+		if result.Status != StatusError {
+			t.Errorf("status = %v, want error: %s", result.Status, result.Message)
+		}
+		if len(result.Details) != 1 || !strings.Contains(result.Details[0], "org-personal") {
+			t.Errorf("details = %v, want one line naming the on-disk org", result.Details)
+		}
+	})
+
+	t.Run("invalid config lists every error", func(t *testing.T) {
+		saveAccounts(t, townRoot, map[string]config.Account{
+			"work":  {Email: "w@example.com", ConfigDir: complete, OrgID: "org-1"},
+			"clone": {Email: "c@example.com", ConfigDir: complete},
+		})
+		result := NewAccountConfigCheck().Run(&CheckContext{TownRoot: townRoot})
+		if result.Status != StatusError {
+			t.Errorf("status = %v, want error: %s", result.Status, result.Message)
+		}
+		if len(result.Details) != 2 {
+			t.Errorf("details = %v, want shared config_dir and bad org_id", result.Details)
+		}
+	})
+}
+
+func TestAccountConfigCheck_NoAccounts(t *testing.T) {
+	result := NewAccountConfigCheck().Run(&CheckContext{TownRoot: t.TempDir()})
+	if result.Status != StatusOK {
+		t.Errorf("status = %v, want OK: %s", result.Status, result.Message)
+	}
+}
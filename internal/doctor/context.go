@@ -0,0 +1,65 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// NewCheckContext returns a context for townRoot with its environment
+// (accounts, tmux, daemon) already detected.
+func NewCheckContext(townRoot string) (*CheckContext, error) {
+	if townRoot == "" {
+		return nil, fmt.Errorf("town root required")
+	}
+	ctx := &CheckContext{TownRoot: townRoot}
+	ctx.DetectEnvironment()
+	return ctx, nil
+}
+
+// DetectEnvironment fills in Accounts, TmuxAvailable and DaemonRunning.
+// Detection is best-effort: anything that can't be determined is left at
+// its zero value, and the checks that care report the underlying problem.
+func (ctx *CheckContext) DetectEnvironment() {
+	ctx.Accounts, _ = config.LoadAccountsConfig(constants.MayorAccountsPath(ctx.TownRoot))
+	ctx.TmuxAvailable = tmuxServerRunning()
+	ctx.DaemonRunning, _, _ = daemon.IsRunning(ctx.TownRoot)
+	ctx.envDetected = true
+}
+
+// tmuxServerRunning reports whether tmux is installed and has a server with
+// at least one session.
+func tmuxServerRunning() bool {
+	t := tmux.NewTmux()
+	if !t.IsAvailable() {
+		return false
+	}
+	sessions, err := t.ListSessions()
+	return err == nil && len(sessions) > 0
+}
+
+// tmuxDependent is implemented by checks that only inspect tmux state and
+// have nothing to report when no tmux server is running.
+type tmuxDependent interface {
+	RequiresTmux() bool
+}
+
+// skipWithoutTmux returns an OK result for tmux-dependent checks when the
+// detected environment has no tmux server, or nil if check should run.
+func skipWithoutTmux(ctx *CheckContext, check Check) *CheckResult {
+	if ctx == nil || !ctx.envDetected || ctx.TmuxAvailable {
+		return nil
+	}
+	if td, ok := check.(tmuxDependent); !ok || !td.RequiresTmux() {
+		return nil
+	}
+	return &CheckResult{
+		Name:     check.Name(),
+		Status:   StatusOK,
+		Message:  "tmux not running",
+		Category: checkCategory(check),
+	}
+}
@@ -0,0 +1,89 @@
+package doctor
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// mockTmuxCheck is a mockCheck that declares a tmux dependency.
+type mockTmuxCheck struct {
+	mockCheck
+}
+
+func (m *mockTmuxCheck) RequiresTmux() bool { return true }
+
+func TestNewCheckContext(t *testing.T) {
+	if _, err := NewCheckContext(""); err == nil {
+		t.Error("NewCheckContext(\"\") should fail")
+	}
+
+	townRoot := t.TempDir()
+	ctx, err := NewCheckContext(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctx.TownRoot != townRoot || !ctx.envDetected {
+		t.Errorf("ctx = %+v, want detected context for %s", ctx, townRoot)
+	}
+	if ctx.Accounts != nil || ctx.DaemonRunning {
+		t.Errorf("empty town: Accounts = %v, DaemonRunning = %v", ctx.Accounts, ctx.DaemonRunning)
+	}
+}
+
+func TestCheckContext_TmuxUnavailableSkipsTmuxChecks(t *testing.T) {
+	ctx, err := NewCheckContext(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx.TmuxAvailable = false
+
+	tmuxCheck := &mockTmuxCheck{mockCheck{BaseCheck: BaseCheck{CheckName: "tmux-thing"}, status: StatusError, fixable: true}}
+	plain := &mockCheck{BaseCheck: BaseCheck{CheckName: "plain"}, status: StatusOK}
+	accessor := &mockGlobalEnvAccessor{}
+	envCheck := NewTmuxGlobalEnvCheckWithAccessor(accessor)
+
+	d := NewDoctor()
+	d.RegisterAll(tmuxCheck, plain, envCheck)
+	for _, report := range []*Report{d.Run(ctx), d.Fix(ctx)} {
+		for _, r := range report.Checks {
+			if r.Name == "plain" {
+				continue
+			}
+			if r.Status != StatusOK || r.Message != "tmux not running" {
+				t.Errorf("%s = %v %q, want OK \"tmux not running\"", r.Name, r.Status, r.Message)
+			}
+		}
+	}
+	if tmuxCheck.runCount != 0 || tmuxCheck.fixCount != 0 {
+		t.Errorf("tmux check ran %d times, fixed %d times; want skipped", tmuxCheck.runCount, tmuxCheck.fixCount)
+	}
+	if accessor.env != nil {
+		t.Error("tmux-global-env fix ran without tmux")
+	}
+	if plain.runCount != 2 {
+		t.Errorf("plain check ran %d times, want 2", plain.runCount)
+	}
+
+	// With tmux available (or an undetected context) the check runs.
+	ctx.TmuxAvailable = true
+	d.Run(ctx)
+	d.Run(&CheckContext{})
+	if tmuxCheck.runCount != 2 {
+		t.Errorf("tmux check ran %d times, want 2", tmuxCheck.runCount)
+	}
+}
+
+func TestAccountConfigCheck_UsesContextAccounts(t *testing.T) {
+	ctx := &CheckContext{
+		TownRoot: t.TempDir(), // no accounts.json on disk
+		Accounts: &config.AccountsConfig{
+			Version:  config.CurrentAccountsVersion,
+			Accounts: map[string]config.Account{"work": {ConfigDir: "/nonexistent/work", OrgID: "org-1"}},
+		},
+	}
+	result := NewAccountConfigCheck().Run(ctx)
+	if result.Status != StatusError || result.Message != "Invalid accounts config" {
+		t.Errorf("result = %v %q, want validation error from ctx.Accounts", result.Status, result.Message)
+	}
+}
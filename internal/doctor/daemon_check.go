@@ -81,6 +81,7 @@ func (c *DaemonCheck) Fix(ctx *CheckContext) error {
 	// Start daemon in background (detach from parent I/O - daemon uses its own logging)
 	cmd := exec.Command(gtPath, "daemon", "run")
 	cmd.Dir = ctx.TownRoot
+	cmd.Env = daemon.Env(ctx.TownRoot)
 	cmd.Stdin = nil
 	cmd.Stdout = nil
 	cmd.Stderr = nil
@@ -0,0 +1,101 @@
+package doctor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/steveyegge/gastown/internal/daemon"
+)
+
+// Thresholds for DaemonDiskCheck, as fractions of the daemon disk budget.
+const (
+	daemonDiskWarnRatio  = 0.80
+	daemonDiskErrorRatio = 0.95
+)
+
+// DaemonDiskCheck reports how close the daemon/ directory is to the disk
+// budget that log cleanup enforces. The daemon trims it after rotation, but
+// a stopped daemon or a burst of logging can leave it near or over budget.
+type DaemonDiskCheck struct {
+	FixableCheck
+	budget int64 // overrides the daemon budget when set (for testing)
+}
+
+// NewDaemonDiskCheck creates a new daemon disk usage check.
+func NewDaemonDiskCheck() *DaemonDiskCheck {
+	return &DaemonDiskCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "daemon-disk",
+				CheckDescription: "Check daemon/ directory size against its disk budget",
+				CheckCategory:    CategoryCleanup,
+			},
+		},
+	}
+}
+
+// Run compares daemon/ usage with the budget.
+func (c *DaemonDiskCheck) Run(ctx *CheckContext) *CheckResult {
+	used, budget, err := daemon.DaemonDirUsage(ctx.TownRoot)
+	if os.IsNotExist(err) {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No daemon directory",
+		}
+	}
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not measure daemon directory",
+			Details: []string{err.Error()},
+		}
+	}
+	if c.budget > 0 {
+		budget = c.budget
+	}
+
+	free := budget - used
+	if free < 0 {
+		free = 0
+	}
+	ratio := float64(used) / float64(budget)
+	details := []string{
+		"UsedBytes: " + formatBytes(used),
+		"BudgetBytes: " + formatBytes(budget),
+		"FreeBytes: " + formatBytes(free),
+	}
+	message := fmt.Sprintf("daemon/ uses %.0f%% of its %s budget", ratio*100, formatBytes(budget))
+
+	switch {
+	case ratio > daemonDiskErrorRatio:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: message,
+			Details: details,
+			FixHint: "Run 'gt doctor --fix' to remove stale and oldest log archives",
+		}
+	case ratio > daemonDiskWarnRatio:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: message,
+			Details: details,
+			FixHint: "Run 'gt doctor --fix' to remove stale and oldest log archives",
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: message,
+		Details: details,
+	}
+}
+
+// Fix runs the daemon's archive cleanup.
+func (c *DaemonDiskCheck) Fix(ctx *CheckContext) error {
+	return errors.Join(daemon.CleanDaemonDir(ctx.TownRoot).Errors...)
+}
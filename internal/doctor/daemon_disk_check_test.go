@@ -0,0 +1,59 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDaemonDiskCheck_Thresholds(t *testing.T) {
+	tests := []struct {
+		name string
+		used int64
+		want CheckStatus
+	}{
+		{"well under budget", 500, StatusOK},
+		{"exactly 80%", 800, StatusOK},
+		{"over 80%", 850, StatusWarning},
+		{"exactly 95%", 950, StatusWarning},
+		{"over 95%", 960, StatusError},
+		{"over budget", 1200, StatusError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			townRoot := t.TempDir()
+			daemonDir := filepath.Join(townRoot, "daemon")
+			if err := os.MkdirAll(daemonDir, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			// Split usage across a live log and an archive.
+			half := tt.used / 2
+			if err := os.WriteFile(filepath.Join(daemonDir, "daemon.log"), make([]byte, half), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(daemonDir, "dolt.log.1.gz"), make([]byte, tt.used-half), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			check := NewDaemonDiskCheck()
+			check.budget = 1000
+			result := check.Run(&CheckContext{TownRoot: townRoot})
+			if result.Status != tt.want {
+				t.Errorf("status = %v, want %v (%s)", result.Status, tt.want, result.Message)
+			}
+			if len(result.Details) != 3 {
+				t.Errorf("details = %v, want used/budget/free", result.Details)
+			}
+		})
+	}
+}
+
+func TestDaemonDiskCheck_NoDaemonDir(t *testing.T) {
+	check := NewDaemonDiskCheck()
+	if result := check.Run(&CheckContext{TownRoot: t.TempDir()}); result.Status != StatusOK {
+		t.Errorf("status = %v, want OK: %s", result.Status, result.Message)
+	}
+	if !check.CanFix() {
+		t.Error("CanFix() = false, want true")
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/ui"
@@ -41,6 +43,93 @@ type categoryGetter interface {
 	Category() string
 }
 
+// RunAll runs checks in category order (CategoryOrder, uncategorized last;
+// registration order within a category) and returns their results in that
+// order. ctx.StopOnCategory enables short-circuiting; see CheckContext.
+// The context's environment is detected first unless NewCheckContext or
+// DetectEnvironment already did so.
+func RunAll(ctx *CheckContext, checks []Check) []*CheckResult {
+	if !ctx.envDetected {
+		ctx.DetectEnvironment()
+	}
+	d := &Doctor{checks: sortChecksByCategory(checks)}
+	return d.Run(ctx).Checks
+}
+
+// checkCategory returns the check's category, or "" if it has none.
+func checkCategory(check Check) string {
+	if cg, ok := check.(categoryGetter); ok {
+		return cg.Category()
+	}
+	return ""
+}
+
+// categoryRank returns the position of category in CategoryOrder, matched
+// case-insensitively. Unknown categories rank after all known ones.
+func categoryRank(category string) int {
+	for i, c := range CategoryOrder {
+		if strings.EqualFold(c, category) {
+			return i
+		}
+	}
+	return len(CategoryOrder)
+}
+
+// sortChecksByCategory returns a copy of checks stably sorted by category rank.
+func sortChecksByCategory(checks []Check) []Check {
+	sorted := make([]Check, len(checks))
+	copy(sorted, checks)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return categoryRank(checkCategory(sorted[i])) < categoryRank(checkCategory(sorted[j]))
+	})
+	return sorted
+}
+
+// categoryGate implements CheckContext.StopOnCategory: once a check in the
+// stop category errors, checks in later categories are skipped.
+type categoryGate struct {
+	stopRank int    // rank of the stop category; -1 when disabled
+	failed   string // name of the check that tripped the gate
+}
+
+func newCategoryGate(ctx *CheckContext) *categoryGate {
+	if ctx == nil || ctx.StopOnCategory == "" {
+		return &categoryGate{stopRank: -1}
+	}
+	return &categoryGate{stopRank: categoryRank(ctx.StopOnCategory)}
+}
+
+// order returns checks in the order they should run.
+func (g *categoryGate) order(checks []Check) []Check {
+	if g.stopRank < 0 {
+		return checks
+	}
+	return sortChecksByCategory(checks)
+}
+
+// skip returns a skipped result if check must not run, or nil.
+func (g *categoryGate) skip(check Check) *CheckResult {
+	if g.failed == "" || categoryRank(checkCategory(check)) <= g.stopRank {
+		return nil
+	}
+	return &CheckResult{
+		Name:     check.Name(),
+		Status:   StatusWarning,
+		Message:  fmt.Sprintf("Skipped: %s check %s failed", strings.ToLower(CategoryOrder[g.stopRank]), g.failed),
+		Category: checkCategory(check),
+	}
+}
+
+// observe records result so later checks can be skipped.
+func (g *categoryGate) observe(check Check, result *CheckResult) {
+	if g.stopRank < 0 || g.failed != "" || result.Status != StatusError {
+		return
+	}
+	if categoryRank(checkCategory(check)) == g.stopRank {
+		g.failed = check.Name()
+	}
+}
+
 // Run executes all registered checks and returns a report.
 func (d *Doctor) Run(ctx *CheckContext) *Report {
 	return d.RunStreaming(ctx, nil, 0)
@@ -51,8 +140,24 @@ func (d *Doctor) Run(ctx *CheckContext) *Report {
 // If slowThreshold > 0, shows hourglass icon for slow checks.
 func (d *Doctor) RunStreaming(ctx *CheckContext, w io.Writer, slowThreshold time.Duration) *Report {
 	report := NewReport()
+	gate := newCategoryGate(ctx)
+
+	for _, check := range gate.order(d.checks) {
+		if skipped := gate.skip(check); skipped != nil {
+			if w != nil {
+				fmt.Fprintf(w, "  %s  %s%s\n", ui.RenderWarnIcon(), skipped.Name, ui.RenderMuted(" "+skipped.Message))
+			}
+			report.Add(skipped)
+			continue
+		}
+		if skipped := skipWithoutTmux(ctx, check); skipped != nil {
+			if w != nil {
+				fmt.Fprintf(w, "  %s  %s%s\n", ui.RenderPassIcon(), skipped.Name, ui.RenderMuted(" "+skipped.Message))
+			}
+			report.Add(skipped)
+			continue
+		}
 
-	for _, check := range d.checks {
 		// Stream: print check name before running
 		if w != nil {
 			fmt.Fprintf(w, "  %s  %s...", ui.RenderMuted("○"), check.Name())
@@ -99,6 +204,7 @@ func (d *Doctor) RunStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 			fmt.Fprintln(w)
 		}
 
+		gate.observe(check, result)
 		report.Add(result)
 	}
 
@@ -128,8 +234,24 @@ func safeFixCheck(check Check, ctx *CheckContext) (retErr error) {
 // If slowThreshold > 0, shows hourglass icon for slow checks.
 func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time.Duration) *Report {
 	report := NewReport()
+	gate := newCategoryGate(ctx)
+
+	for _, check := range gate.order(d.checks) {
+		if skipped := gate.skip(check); skipped != nil {
+			if w != nil {
+				fmt.Fprintf(w, "  %s  %s%s\n", ui.RenderWarnIcon(), skipped.Name, ui.RenderMuted(" "+skipped.Message))
+			}
+			report.Add(skipped)
+			continue
+		}
+		if skipped := skipWithoutTmux(ctx, check); skipped != nil {
+			if w != nil {
+				fmt.Fprintf(w, "  %s  %s%s\n", ui.RenderPassIcon(), skipped.Name, ui.RenderMuted(" "+skipped.Message))
+			}
+			report.Add(skipped)
+			continue
+		}
 
-	for _, check := range d.checks {
 		// Stream: print check name before running
 		if w != nil {
 			fmt.Fprintf(w, "  %s  %s...", ui.RenderMuted("○"), check.Name())
@@ -178,6 +300,8 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 				if result.Status == StatusOK {
 					result.Message = result.Message + " (fixed)"
 					result.Fixed = true
+				} else {
+					result.FixError = "still reports " + strings.ToLower(result.Status.String()) + " after fix"
 				}
 			} else if errors.Is(err, ErrSkippedNoStart) {
 				// Fix skipped due to --no-start flag
@@ -185,6 +309,7 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 			} else {
 				// Fix failed, add error to details
 				result.Details = append(result.Details, "Fix failed: "+err.Error())
+				result.FixError = err.Error()
 			}
 		}
 
@@ -227,12 +352,26 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 			fmt.Fprintln(w)
 		}
 
+		gate.observe(check, result)
 		report.Add(result)
 	}
 
 	return report
 }
 
+// FixOnly runs FixStreaming for the single registered check called name.
+// It returns an error if no such check is registered.
+func (d *Doctor) FixOnly(ctx *CheckContext, name string, w io.Writer, slowThreshold time.Duration) (*Report, error) {
+	for _, check := range d.checks {
+		if check.Name() == name {
+			single := NewDoctor()
+			single.Register(check)
+			return single.FixStreaming(ctx, w, slowThreshold), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown check %q", name)
+}
+
 // BaseCheck provides a base implementation for checks that don't support auto-fix.
 // Embed this in custom checks to get default CanFix() and Fix() implementations.
 type BaseCheck struct {
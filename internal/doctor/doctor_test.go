@@ -2,6 +2,7 @@ package doctor
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -14,6 +15,7 @@ type mockCheck struct {
 	fixable  bool
 	fixError error
 	fixCount int
+	runCount int
 }
 
 func newMockCheck(name string, status CheckStatus) *mockCheck {
@@ -27,6 +29,7 @@ func newMockCheck(name string, status CheckStatus) *mockCheck {
 }
 
 func (m *mockCheck) Run(ctx *CheckContext) *CheckResult {
+	m.runCount++
 	return &CheckResult{
 		Name:    m.CheckName,
 		Status:  m.status,
@@ -332,6 +335,195 @@ func TestDoctor_Fix(t *testing.T) {
 	}
 }
 
+func TestDoctor_FixReportsAttempts(t *testing.T) {
+	// A broken tmux global env should be repaired by a --fix run, and the
+	// fix summary should report both the repair and the failing fix.
+	d := NewDoctor()
+	mock := &mockGlobalEnvAccessor{env: map[string]string{"GT_ROOT": "/wrong/path"}}
+	d.Register(NewTmuxGlobalEnvCheckWithAccessor(mock))
+
+	broken := newMockCheck("memory-symlinks", StatusWarning)
+	broken.fixable = true
+	broken.fixError = fmt.Errorf("permission denied")
+	d.Register(broken)
+
+	ctx := &CheckContext{TownRoot: "/home/user/gt"}
+	report := d.Fix(ctx)
+
+	if got := mock.env["GT_ROOT"]; got != ctx.TownRoot {
+		t.Errorf("GT_ROOT = %q after fix, want %q", got, ctx.TownRoot)
+	}
+	if len(report.FixAttempts()) != 2 {
+		t.Fatalf("FixAttempts() = %d, want 2", len(report.FixAttempts()))
+	}
+	if report.Checks[1].FixError != "permission denied" {
+		t.Errorf("FixError = %q, want %q", report.Checks[1].FixError, "permission denied")
+	}
+
+	var buf bytes.Buffer
+	report.PrintFixes(&buf)
+	out := buf.String()
+	if !strings.Contains(out, "fixed tmux-global-env") {
+		t.Errorf("PrintFixes output missing fixed line:\n%s", out)
+	}
+	if !strings.Contains(out, "failed to fix memory-symlinks: permission denied") {
+		t.Errorf("PrintFixes output missing failure line:\n%s", out)
+	}
+
+	// The final re-run sees the corrected environment.
+	if final := d.Run(ctx); final.Checks[0].Status != StatusOK {
+		t.Errorf("tmux-global-env after fix = %v: %s", final.Checks[0].Status, final.Checks[0].Message)
+	}
+}
+
+func TestDoctor_FixOnly(t *testing.T) {
+	d := NewDoctor()
+	first := newMockCheck("first", StatusError)
+	first.fixable = true
+	second := newMockCheck("second", StatusError)
+	second.fixable = true
+	d.RegisterAll(first, second)
+
+	ctx := &CheckContext{TownRoot: "/test"}
+	report, err := d.FixOnly(ctx, "second", nil, 0)
+	if err != nil {
+		t.Fatalf("FixOnly: %v", err)
+	}
+	if len(report.Checks) != 1 || !report.Checks[0].Fixed {
+		t.Errorf("expected only second to be fixed, got %+v", report.Checks)
+	}
+	if first.runCount != 0 || first.fixCount != 0 {
+		t.Error("FixOnly should not touch other checks")
+	}
+
+	if _, err := d.FixOnly(ctx, "missing", nil, 0); err == nil {
+		t.Error("expected error for unknown check")
+	}
+}
+
+func newCategoryMockCheck(name, category string, status CheckStatus) *mockCheck {
+	m := newMockCheck(name, status)
+	m.CheckCategory = category
+	return m
+}
+
+func resultNames(results []*CheckResult) []string {
+	names := make([]string, len(results))
+	for i, r := range results {
+		names[i] = r.Name
+	}
+	return names
+}
+
+func TestRunAll_OrdersByCategory(t *testing.T) {
+	checks := []Check{
+		newCategoryMockCheck("cfg-a", CategoryConfig, StatusOK),
+		newMockCheck("uncategorized", StatusOK),
+		newCategoryMockCheck("infra-a", CategoryInfrastructure, StatusOK),
+		newCategoryMockCheck("cfg-b", CategoryConfig, StatusOK),
+		newCategoryMockCheck("infra-b", CategoryInfrastructure, StatusOK),
+		newCategoryMockCheck("core", CategoryCore, StatusOK),
+	}
+
+	got := strings.Join(resultNames(RunAll(&CheckContext{}, checks)), ",")
+	want := "core,infra-a,infra-b,cfg-a,cfg-b,uncategorized"
+	if got != want {
+		t.Errorf("RunAll order = %s, want %s", got, want)
+	}
+	if name := checks[0].Name(); name != "cfg-a" {
+		t.Errorf("RunAll reordered the caller's slice (checks[0] = %s)", name)
+	}
+}
+
+func TestRunAll_StopOnCategorySkipsLaterChecks(t *testing.T) {
+	infraOK := newCategoryMockCheck("infra-ok", CategoryInfrastructure, StatusOK)
+	infraBad := newCategoryMockCheck("infra-bad", CategoryInfrastructure, StatusError)
+	infraAfter := newCategoryMockCheck("infra-after", CategoryInfrastructure, StatusOK)
+	cfg := newCategoryMockCheck("cfg", CategoryConfig, StatusOK)
+	core := newCategoryMockCheck("core", CategoryCore, StatusOK)
+	checks := []Check{cfg, infraOK, infraBad, infraAfter, core}
+
+	results := RunAll(&CheckContext{StopOnCategory: "infrastructure"}, checks)
+
+	if got := strings.Join(resultNames(results), ","); got != "core,infra-ok,infra-bad,infra-after,cfg" {
+		t.Fatalf("results = %s", got)
+	}
+	if infraAfter.runCount != 1 {
+		t.Errorf("checks in the failing category should still run (ran %d times)", infraAfter.runCount)
+	}
+	if cfg.runCount != 0 {
+		t.Errorf("config check ran %d times, want skipped", cfg.runCount)
+	}
+	skipped := results[4]
+	if skipped.Status != StatusWarning || !strings.Contains(skipped.Message, "infra-bad") {
+		t.Errorf("skipped result = %+v, want warning naming infra-bad", skipped)
+	}
+}
+
+func TestRunAll_StopOnCategoryNoFailure(t *testing.T) {
+	cfg := newCategoryMockCheck("cfg", CategoryConfig, StatusError)
+	infra := newCategoryMockCheck("infra", CategoryInfrastructure, StatusWarning)
+
+	RunAll(&CheckContext{StopOnCategory: "infrastructure"}, []Check{cfg, infra})
+
+	if cfg.runCount != 1 {
+		t.Errorf("config check ran %d times, want 1 (warnings do not short-circuit)", cfg.runCount)
+	}
+}
+
+func TestReport_WriteJSON(t *testing.T) {
+	d := NewDoctor()
+	d.Register(newMockCheck("ok", StatusOK))
+	d.Register(newMockCheck("warn", StatusWarning))
+	d.Register(newMockCheck("err-a", StatusError))
+	d.Register(newMockCheck("err-b", StatusError))
+	report := d.Run(&CheckContext{TownRoot: "/test"})
+
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	var all []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &all); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if len(all) != 4 {
+		t.Fatalf("got %d results, want all 4 executed checks", len(all))
+	}
+	if all[1]["name"] != "warn" || all[1]["status"] != "warning" || all[1]["message"] != "mock result" {
+		t.Errorf("result[1] = %v", all[1])
+	}
+
+	buf.Reset()
+	if err := report.WriteJSON(&buf, StatusError); err != nil {
+		t.Fatalf("WriteJSON filtered: %v", err)
+	}
+	var errs []*CheckResult
+	if err := json.Unmarshal(buf.Bytes(), &errs); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if got := strings.Join(resultNames(errs), ","); got != "err-a,err-b" {
+		t.Errorf("filtered = %s, want err-a,err-b", got)
+	}
+	for _, r := range errs {
+		if r.Status != StatusError {
+			t.Errorf("%s status = %v after round trip, want error", r.Name, r.Status)
+		}
+	}
+}
+
+func TestParseCheckStatus(t *testing.T) {
+	for in, want := range map[string]CheckStatus{"ok": StatusOK, "Warning": StatusWarning, "ERROR": StatusError} {
+		got, err := ParseCheckStatus(in)
+		if err != nil || got != want {
+			t.Errorf("ParseCheckStatus(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	if _, err := ParseCheckStatus("fatal"); err == nil {
+		t.Error("ParseCheckStatus(fatal) succeeded, want error")
+	}
+}
+
 func TestBaseCheck(t *testing.T) {
 	b := &BaseCheck{
 		CheckName:        "test",
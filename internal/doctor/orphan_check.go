@@ -53,6 +53,10 @@ func NewOrphanSessionCheckWithSessionLister(lister SessionLister) *OrphanSession
 	return check
 }
 
+// RequiresTmux is true: orphans are tmux sessions with no matching rig or
+// agent, so a missing server has none.
+func (c *OrphanSessionCheck) RequiresTmux() bool { return true }
+
 // Run checks for orphaned Gas Town tmux sessions.
 func (c *OrphanSessionCheck) Run(ctx *CheckContext) *CheckResult {
 	lister := c.sessionLister
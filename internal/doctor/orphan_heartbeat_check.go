@@ -0,0 +1,112 @@
+package doctor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// OrphanHeartbeatCheck detects stale session heartbeat files whose tmux
+// session no longer exists. Polecat cleanup normally removes the heartbeat;
+// one left behind after a crash keeps showing up as a stale session.
+type OrphanHeartbeatCheck struct {
+	FixableCheck
+	sessionLister    SessionLister
+	orphanHeartbeats []string // session names, cached during Run for use in Fix
+}
+
+// NewOrphanHeartbeatCheck creates a new orphan heartbeat check.
+func NewOrphanHeartbeatCheck() *OrphanHeartbeatCheck {
+	return &OrphanHeartbeatCheck{
+		FixableCheck: FixableCheck{
+			BaseCheck: BaseCheck{
+				CheckName:        "orphan-heartbeats",
+				CheckDescription: "Detect stale heartbeat files for dead sessions",
+				CheckCategory:    CategoryCleanup,
+			},
+		},
+	}
+}
+
+// NewOrphanHeartbeatCheckWithSessionLister creates a check with a custom session lister (for testing).
+func NewOrphanHeartbeatCheckWithSessionLister(lister SessionLister) *OrphanHeartbeatCheck {
+	check := NewOrphanHeartbeatCheck()
+	check.sessionLister = lister
+	return check
+}
+
+// Run lists stale heartbeats and reports those without a live tmux session.
+func (c *OrphanHeartbeatCheck) Run(ctx *CheckContext) *CheckResult {
+	c.orphanHeartbeats = nil
+
+	threshold := polecat.LoadHeartbeatConfig(ctx.TownRoot).StaleThreshold
+	stale, err := polecat.ListStaleHeartbeats(ctx.TownRoot, threshold)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not read heartbeats directory",
+			Details: []string{err.Error()},
+		}
+	}
+	if len(stale) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No stale heartbeat files",
+		}
+	}
+
+	lister := c.sessionLister
+	if lister == nil {
+		lister = &realSessionLister{t: tmux.NewTmux()}
+	}
+	sessions, err := lister.ListSessions()
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not list tmux sessions",
+			Details: []string{err.Error()},
+		}
+	}
+	live := make(map[string]bool, len(sessions))
+	for _, s := range sessions {
+		live[s] = true
+	}
+
+	var details []string
+	for _, hb := range stale {
+		if live[hb.SessionID] {
+			continue // stale but still running; the witness handles stuck sessions
+		}
+		c.orphanHeartbeats = append(c.orphanHeartbeats, hb.SessionID)
+		details = append(details, fmt.Sprintf("%s (last beat %s ago)", hb.SessionID, hb.Age.Round(time.Second)))
+	}
+
+	if len(c.orphanHeartbeats) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("%d stale heartbeat(s), all with live sessions", len(stale)),
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("Found %d orphaned heartbeat file(s)", len(c.orphanHeartbeats)),
+		Details: details,
+		FixHint: "Run 'gt doctor --fix' to remove orphaned heartbeat files",
+	}
+}
+
+// Fix removes the orphaned heartbeat files found by Run.
+func (c *OrphanHeartbeatCheck) Fix(ctx *CheckContext) error {
+	for _, name := range c.orphanHeartbeats {
+		polecat.RemoveSessionHeartbeat(ctx.TownRoot, name)
+	}
+	c.orphanHeartbeats = nil
+	return nil
+}
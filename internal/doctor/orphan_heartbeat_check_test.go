@@ -0,0 +1,66 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func writeStaleHeartbeat(t *testing.T, townRoot, session string) string {
+	t.Helper()
+	polecat.TouchSessionHeartbeat(townRoot, session)
+	path := filepath.Join(townRoot, ".runtime", "heartbeats", session+".json")
+	old := time.Now().Add(-time.Hour).UTC()
+	data := []byte(`{"timestamp":"` + old.Format(time.RFC3339Nano) + `"}`)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("write heartbeat: %v", err)
+	}
+	return path
+}
+
+func TestOrphanHeartbeatCheck(t *testing.T) {
+	townRoot := t.TempDir()
+	alivePath := writeStaleHeartbeat(t, townRoot, "gt-gastown-alive")
+	deadPath := writeStaleHeartbeat(t, townRoot, "gt-gastown-dead")
+
+	check := NewOrphanHeartbeatCheckWithSessionLister(&mockSessionLister{
+		sessions: []string{"gt-gastown-alive", "hq-mayor"},
+	})
+	ctx := &CheckContext{TownRoot: townRoot}
+
+	result := check.Run(ctx)
+	if result.Status != StatusWarning {
+		t.Fatalf("status = %v, want warning: %s", result.Status, result.Message)
+	}
+	if len(result.Details) != 1 || !strings.Contains(result.Details[0], "gt-gastown-dead") {
+		t.Errorf("details = %v, want only gt-gastown-dead", result.Details)
+	}
+	if !check.CanFix() {
+		t.Error("CanFix() = false, want true")
+	}
+
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if _, err := os.Stat(deadPath); !os.IsNotExist(err) {
+		t.Errorf("orphaned heartbeat still exists after Fix")
+	}
+	if _, err := os.Stat(alivePath); err != nil {
+		t.Errorf("heartbeat for live session was removed: %v", err)
+	}
+
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Errorf("status after fix = %v, want OK: %s", result.Status, result.Message)
+	}
+}
+
+func TestOrphanHeartbeatCheck_NoHeartbeats(t *testing.T) {
+	check := NewOrphanHeartbeatCheckWithSessionLister(&mockSessionLister{})
+	if result := check.Run(&CheckContext{TownRoot: t.TempDir()}); result.Status != StatusOK {
+		t.Errorf("status = %v, want OK: %s", result.Status, result.Message)
+	}
+}
@@ -208,8 +208,14 @@ func (c *RoutesCheck) Run(ctx *CheckContext) *CheckResult {
 		}
 	}
 
+	// Check for overlapping routes whose resolution is arbitrary or shadowed
+	ambiguous := beads.ValidateRoutes(routes)
+	for _, err := range ambiguous {
+		details = append(details, err.Error())
+	}
+
 	// Determine result
-	if missingTownRoute || missingConvoyRoute || len(missingRigs) > 0 || len(invalidRoutes) > 0 || len(suboptimalRoutes) > 0 {
+	if missingTownRoute || missingConvoyRoute || len(missingRigs) > 0 || len(invalidRoutes) > 0 || len(suboptimalRoutes) > 0 || len(ambiguous) > 0 {
 		status := StatusWarning
 		var messageParts []string
 
@@ -228,6 +234,9 @@ func (c *RoutesCheck) Run(ctx *CheckContext) *CheckResult {
 		if len(suboptimalRoutes) > 0 {
 			messageParts = append(messageParts, fmt.Sprintf("%d route(s) using redirect instead of canonical path", len(suboptimalRoutes)))
 		}
+		if len(ambiguous) > 0 {
+			messageParts = append(messageParts, fmt.Sprintf("%d ambiguous route(s)", len(ambiguous)))
+		}
 
 		return &CheckResult{
 			Name:    c.Name(),
@@ -262,11 +271,23 @@ func (c *RoutesCheck) checkRoutesValid(ctx *CheckContext, routes []beads.Route)
 		}
 	}
 
-	if invalidCount > 0 {
+	ambiguous := beads.ValidateRoutes(routes)
+	for _, err := range ambiguous {
+		details = append(details, err.Error())
+	}
+
+	if invalidCount > 0 || len(ambiguous) > 0 {
+		var messageParts []string
+		if invalidCount > 0 {
+			messageParts = append(messageParts, fmt.Sprintf("%d invalid route(s) in routes.jsonl", invalidCount))
+		}
+		if len(ambiguous) > 0 {
+			messageParts = append(messageParts, fmt.Sprintf("%d ambiguous route(s)", len(ambiguous)))
+		}
 		return &CheckResult{
 			Name:    c.Name(),
 			Status:  StatusWarning,
-			Message: fmt.Sprintf("%d invalid route(s) in routes.jsonl", invalidCount),
+			Message: strings.Join(messageParts, ", "),
 			Details: details,
 			FixHint: "Remove invalid routes or recreate the missing rigs",
 		}
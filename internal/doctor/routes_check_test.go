@@ -689,3 +689,37 @@ func TestRoutesCheck_CorruptedRoutesJsonl(t *testing.T) {
 		}
 	})
 }
+
+func TestRoutesCheck_AmbiguousRoutes(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range []string{"mayor", "gastown", "crew"} {
+		if err := os.MkdirAll(filepath.Join(tmpDir, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// gt-crew- can never match: gt- has a lower priority and a different path.
+	routesContent := `{"prefix": "hq-", "path": "."}
+{"prefix": "hq-cv-", "path": "."}
+{"prefix": "gt-", "path": "gastown"}
+{"prefix": "gt-crew-", "path": "crew", "priority": 5}
+`
+	if err := os.WriteFile(filepath.Join(beadsDir, "routes.jsonl"), []byte(routesContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := NewRoutesCheck().Run(&CheckContext{TownRoot: tmpDir})
+	if result.Status != StatusWarning {
+		t.Fatalf("expected StatusWarning, got %v: %s", result.Status, result.Message)
+	}
+	if !strings.Contains(result.Message, "1 ambiguous route(s)") {
+		t.Errorf("expected ambiguous route message, got %q", result.Message)
+	}
+	if len(result.Details) != 1 || !strings.Contains(result.Details[0], `"gt-crew-"`) {
+		t.Errorf("expected detail naming gt-crew-, got %v", result.Details)
+	}
+}
@@ -0,0 +1,114 @@
+package doctor
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/buildinfo"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/daemon"
+	"github.com/steveyegge/gastown/internal/deps"
+	"github.com/steveyegge/gastown/internal/witness"
+)
+
+// RuntimeProvenanceCheck lists runtime state files last written by an older
+// gt than the one running. This is informational: state from older binaries
+// is still loaded, but knowing its origin saves time when debugging schema
+// or default drift.
+type RuntimeProvenanceCheck struct {
+	BaseCheck
+}
+
+// NewRuntimeProvenanceCheck creates a new runtime provenance check.
+func NewRuntimeProvenanceCheck() *RuntimeProvenanceCheck {
+	return &RuntimeProvenanceCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "runtime-provenance",
+			CheckDescription: "List runtime state written by older gt versions",
+			CheckCategory:    CategoryCleanup,
+		},
+	}
+}
+
+// Run inspects stamped runtime files and reports those from older versions.
+func (c *RuntimeProvenanceCheck) Run(ctx *CheckContext) *CheckResult {
+	current := buildinfo.Version()
+
+	files := []string{
+		constants.MayorQuotaPath(ctx.TownRoot),
+		daemon.StateFile(ctx.TownRoot),
+	}
+	feeds, _ := filepath.Glob(witness.PatrolFeedPath(ctx.TownRoot, "*"))
+	files = append(files, feeds...)
+
+	var details []string
+	for _, path := range files {
+		p := readProvenance(path)
+		if p == nil || p.GTVersion == "" || p.GTVersion == current {
+			continue
+		}
+		if deps.CompareVersions(p.GTVersion, current) >= 0 {
+			continue
+		}
+		rel, err := filepath.Rel(ctx.TownRoot, path)
+		if err != nil {
+			rel = path
+		}
+		details = append(details, fmt.Sprintf("%s: written by gt %s at %s",
+			rel, p.GTVersion, p.WrittenAt.Format("2006-01-02 15:04")))
+	}
+
+	if len(details) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No runtime state from older gt versions",
+		}
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: fmt.Sprintf("%d runtime file(s) written by older gt versions (gt %s running)", len(details), current),
+		Details: details,
+	}
+}
+
+// readProvenance returns the provenance recorded in a runtime file: the
+// top-level "provenance" field of a JSON file, or the newest stamped event
+// of a JSONL feed. Returns nil if the file is missing or unstamped.
+func readProvenance(path string) *buildinfo.Provenance {
+	if filepath.Ext(path) == ".jsonl" {
+		f, err := os.Open(path) //nolint:gosec // G304: path is under the town root
+		if err != nil {
+			return nil
+		}
+		defer f.Close()
+		var latest *buildinfo.Provenance
+		sc := bufio.NewScanner(f)
+		sc.Buffer(make([]byte, 64*1024), 1024*1024)
+		for sc.Scan() {
+			var line struct {
+				Provenance *buildinfo.Provenance `json:"provenance"`
+			}
+			if json.Unmarshal(sc.Bytes(), &line) == nil && line.Provenance != nil {
+				latest = line.Provenance
+			}
+		}
+		return latest
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is under the town root
+	if err != nil {
+		return nil
+	}
+	var doc struct {
+		Provenance *buildinfo.Provenance `json:"provenance"`
+	}
+	if json.Unmarshal(data, &doc) != nil {
+		return nil
+	}
+	return doc.Provenance
+}
@@ -0,0 +1,57 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/buildinfo"
+)
+
+func TestRuntimeProvenanceCheck(t *testing.T) {
+	buildinfo.Set("0.12.1", "")
+	t.Cleanup(func() { buildinfo.Set("dev", "") })
+
+	townRoot := t.TempDir()
+	write := func(rel, content string) {
+		t.Helper()
+		path := filepath.Join(townRoot, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	check := NewRuntimeProvenanceCheck()
+	result := check.Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusOK || len(result.Details) != 0 {
+		t.Fatalf("empty town: %+v", result)
+	}
+
+	write("mayor/quota.json", `{"version":1,"provenance":{"gt_version":"0.11.0","written_at":"2026-01-02T03:04:05Z"}}`)
+	write("daemon/state.json", `{"running":true,"provenance":{"gt_version":"0.12.1","written_at":"2026-01-02T03:04:05Z"}}`)
+	write("gastown/.runtime/witness-patrol.jsonl",
+		`{"type":"patrol-started","provenance":{"gt_version":"0.10.0","written_at":"2026-01-01T00:00:00Z"}}`+"\n"+
+			`not json`+"\n"+
+			`{"type":"patrol-finished"}`+"\n")
+
+	result = check.Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusOK {
+		t.Errorf("check must stay informational, got status %v", result.Status)
+	}
+	if len(result.Details) != 2 {
+		t.Fatalf("expected 2 older files, got %v", result.Details)
+	}
+	joined := strings.Join(result.Details, "\n")
+	for _, want := range []string{"quota.json: written by gt 0.11.0", "witness-patrol.jsonl: written by gt 0.10.0"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("details missing %q: %v", want, result.Details)
+		}
+	}
+	if strings.Contains(joined, "state.json") {
+		t.Errorf("current-version file listed: %v", result.Details)
+	}
+}
@@ -58,6 +58,9 @@ func NewMalformedSessionNameCheck() *MalformedSessionNameCheck {
 	}
 }
 
+// RequiresTmux is true: only the names of live tmux sessions are validated.
+func (c *MalformedSessionNameCheck) RequiresTmux() bool { return true }
+
 // Run detects sessions whose names use the legacy {prefix}-{rig_name}-{role} format.
 func (c *MalformedSessionNameCheck) Run(ctx *CheckContext) *CheckResult {
 	lister := c.sessionListerForTest
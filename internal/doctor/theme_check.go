@@ -27,6 +27,9 @@ func NewThemeCheck() *ThemeCheck {
 	}
 }
 
+// RequiresTmux is true: themes are applied to running tmux sessions.
+func (c *ThemeCheck) RequiresTmux() bool { return true }
+
 // Run checks if tmux sessions have themes applied correctly.
 func (c *ThemeCheck) Run(ctx *CheckContext) *CheckResult {
 	t := tmux.NewTmux()
@@ -28,6 +28,9 @@ func NewLinkedPaneCheck() *LinkedPaneCheck {
 	}
 }
 
+// RequiresTmux is true: linked panes exist only inside a running tmux server.
+func (c *LinkedPaneCheck) RequiresTmux() bool { return true }
+
 // Run checks for linked panes across Gas Town tmux sessions.
 func (c *LinkedPaneCheck) Run(ctx *CheckContext) *CheckResult {
 	t := tmux.NewTmux()
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/tmux"
 )
 
@@ -13,12 +14,16 @@ type GlobalEnvAccessor interface {
 	SetGlobalEnvironment(key, value string) error
 }
 
-// TmuxGlobalEnvCheck verifies that GT_TOWN_ROOT is set in the tmux global
+// TmuxGlobalEnvCheck verifies that GT_ROOT is set in the tmux global
 // environment. This is needed for run-shell subprocesses (e.g., gt cycle
 // next/prev) where CWD is $HOME and process env vars aren't available.
 type TmuxGlobalEnvCheck struct {
 	FixableCheck
 	accessor GlobalEnvAccessor // nil means use real tmux
+
+	// DeprecatedVars are legacy names for GT_ROOT. One of them being set
+	// without GT_ROOT is reported so the environment can be migrated.
+	DeprecatedVars []string
 }
 
 // NewTmuxGlobalEnvCheck creates a new tmux global env check.
@@ -27,10 +32,11 @@ func NewTmuxGlobalEnvCheck() *TmuxGlobalEnvCheck {
 		FixableCheck: FixableCheck{
 			BaseCheck: BaseCheck{
 				CheckName:        "tmux-global-env",
-				CheckDescription: "Verify GT_TOWN_ROOT is set in tmux global environment",
+				CheckDescription: "Verify GT_ROOT is set in tmux global environment",
 				CheckCategory:    CategoryInfrastructure,
 			},
 		},
+		DeprecatedVars: []string{config.EnvTownRootLegacy},
 	}
 }
 
@@ -41,14 +47,18 @@ func NewTmuxGlobalEnvCheckWithAccessor(accessor GlobalEnvAccessor) *TmuxGlobalEn
 	return c
 }
 
-// Run checks that GT_TOWN_ROOT is set correctly in the tmux global environment.
+// RequiresTmux is true: the global environment belongs to the tmux server
+// and goes away with it.
+func (c *TmuxGlobalEnvCheck) RequiresTmux() bool { return true }
+
+// Run checks that GT_ROOT is set correctly in the tmux global environment.
 func (c *TmuxGlobalEnvCheck) Run(ctx *CheckContext) *CheckResult {
 	accessor := c.accessor
 	if accessor == nil {
 		accessor = tmux.NewTmux()
 	}
 
-	val, err := accessor.GetGlobalEnvironment("GT_TOWN_ROOT")
+	val, err := accessor.GetGlobalEnvironment(config.EnvRoot)
 	if err != nil {
 		// No tmux server running — nothing to check or fix.
 		if errors.Is(err, tmux.ErrNoServer) {
@@ -58,16 +68,24 @@ func (c *TmuxGlobalEnvCheck) Run(ctx *CheckContext) *CheckResult {
 				Message: "No tmux server running (nothing to check)",
 			}
 		}
-		// Variable not set (tmux returns error for unknown vars) — warn.
+		// Variable not set (tmux returns error for unknown vars) — warn,
+		// pointing at a deprecated name if that is all that is set.
+		message := config.EnvRoot + " not set in tmux global environment"
+		for _, legacy := range c.DeprecatedVars {
+			if _, err := accessor.GetGlobalEnvironment(legacy); err == nil {
+				message = fmt.Sprintf("%s is deprecated; %s not set", legacy, config.EnvRoot)
+				break
+			}
+		}
 		return &CheckResult{
 			Name:    c.Name(),
 			Status:  StatusWarning,
-			Message: "GT_TOWN_ROOT not set in tmux global environment",
+			Message: message,
 			Details: []string{
-				"The daemon sets GT_TOWN_ROOT in tmux global env for run-shell subprocesses.",
+				"The daemon sets GT_ROOT in tmux global env for run-shell subprocesses.",
 				"Without it, prefix-based cycle groups (prefix+n/p) fail when CWD is $HOME.",
 			},
-			FixHint: "Run 'gt doctor --fix' to set GT_TOWN_ROOT in tmux global env",
+			FixHint: "Run 'gt doctor --fix' to set GT_ROOT in tmux global env",
 		}
 	}
 
@@ -75,27 +93,28 @@ func (c *TmuxGlobalEnvCheck) Run(ctx *CheckContext) *CheckResult {
 		return &CheckResult{
 			Name:    c.Name(),
 			Status:  StatusWarning,
-			Message: fmt.Sprintf("GT_TOWN_ROOT mismatch in tmux global env: %q (expected %q)", val, ctx.TownRoot),
+			Message: fmt.Sprintf("GT_ROOT mismatch in tmux global env: %q (expected %q)", val, ctx.TownRoot),
 			Details: []string{
-				"The daemon sets GT_TOWN_ROOT in tmux global env for run-shell subprocesses.",
+				"The daemon sets GT_ROOT in tmux global env for run-shell subprocesses.",
 				"Without it, prefix-based cycle groups (prefix+n/p) fail when CWD is $HOME.",
 			},
-			FixHint: "Run 'gt doctor --fix' to set GT_TOWN_ROOT in tmux global env",
+			FixHint: "Run 'gt doctor --fix' to set GT_ROOT in tmux global env",
 		}
 	}
 
 	return &CheckResult{
 		Name:    c.Name(),
 		Status:  StatusOK,
-		Message: fmt.Sprintf("GT_TOWN_ROOT=%s in tmux global env", val),
+		Message: fmt.Sprintf("GT_ROOT=%s in tmux global env", val),
 	}
 }
 
-// Fix sets GT_TOWN_ROOT in the tmux global environment.
+// Fix sets GT_ROOT and the legacy GT_TOWN_ROOT in the tmux global environment.
+// GT_TOWN_ROOT is kept for backward compatibility with older readers.
 func (c *TmuxGlobalEnvCheck) Fix(ctx *CheckContext) error {
 	accessor := c.accessor
 	if accessor == nil {
 		accessor = tmux.NewTmux()
 	}
-	return accessor.SetGlobalEnvironment("GT_TOWN_ROOT", ctx.TownRoot)
+	return config.SetRootEnv(accessor.SetGlobalEnvironment, ctx.TownRoot)
 }
@@ -48,14 +48,14 @@ func TestTmuxGlobalEnvCheck_Metadata(t *testing.T) {
 }
 
 func TestTmuxGlobalEnvCheck_Missing(t *testing.T) {
-	// GT_TOWN_ROOT not set — should warn, fix should set it, re-run should pass.
+	// GT_ROOT not set — should warn, fix should set it, re-run should pass.
 	mock := &mockGlobalEnvAccessor{env: map[string]string{}}
 	check := NewTmuxGlobalEnvCheckWithAccessor(mock)
 	ctx := &CheckContext{TownRoot: "/home/user/gt"}
 
 	result := check.Run(ctx)
 	if result.Status != StatusWarning {
-		t.Errorf("expected StatusWarning when GT_TOWN_ROOT missing, got %v: %s", result.Status, result.Message)
+		t.Errorf("expected StatusWarning when GT_ROOT missing, got %v: %s", result.Status, result.Message)
 	}
 
 	// Fix should set the value.
@@ -71,16 +71,16 @@ func TestTmuxGlobalEnvCheck_Missing(t *testing.T) {
 }
 
 func TestTmuxGlobalEnvCheck_WrongValue(t *testing.T) {
-	// GT_TOWN_ROOT set to wrong path — should warn, fix should correct it.
+	// GT_ROOT set to wrong path — should warn, fix should correct it.
 	mock := &mockGlobalEnvAccessor{env: map[string]string{
-		"GT_TOWN_ROOT": "/old/path",
+		"GT_ROOT": "/old/path",
 	}}
 	check := NewTmuxGlobalEnvCheckWithAccessor(mock)
 	ctx := &CheckContext{TownRoot: "/home/user/gt"}
 
 	result := check.Run(ctx)
 	if result.Status != StatusWarning {
-		t.Errorf("expected StatusWarning when GT_TOWN_ROOT wrong, got %v: %s", result.Status, result.Message)
+		t.Errorf("expected StatusWarning when GT_ROOT wrong, got %v: %s", result.Status, result.Message)
 	}
 
 	if err := check.Fix(ctx); err != nil {
@@ -94,7 +94,22 @@ func TestTmuxGlobalEnvCheck_WrongValue(t *testing.T) {
 }
 
 func TestTmuxGlobalEnvCheck_Correct(t *testing.T) {
-	// GT_TOWN_ROOT already correct — should pass.
+	// GT_ROOT already correct — should pass.
+	mock := &mockGlobalEnvAccessor{env: map[string]string{
+		"GT_ROOT": "/home/user/gt",
+	}}
+	check := NewTmuxGlobalEnvCheckWithAccessor(mock)
+	ctx := &CheckContext{TownRoot: "/home/user/gt"}
+
+	result := check.Run(ctx)
+	if result.Status != StatusOK {
+		t.Errorf("expected StatusOK when GT_ROOT correct, got %v: %s", result.Status, result.Message)
+	}
+}
+
+func TestTmuxGlobalEnvCheck_OnlyDeprecatedSet(t *testing.T) {
+	// Only the deprecated GT_TOWN_ROOT is set — should warn about the
+	// deprecation, and fix should add GT_ROOT while keeping GT_TOWN_ROOT.
 	mock := &mockGlobalEnvAccessor{env: map[string]string{
 		"GT_TOWN_ROOT": "/home/user/gt",
 	}}
@@ -102,8 +117,26 @@ func TestTmuxGlobalEnvCheck_Correct(t *testing.T) {
 	ctx := &CheckContext{TownRoot: "/home/user/gt"}
 
 	result := check.Run(ctx)
+	if result.Status != StatusWarning {
+		t.Errorf("expected StatusWarning when only GT_TOWN_ROOT set, got %v: %s", result.Status, result.Message)
+	}
+	if want := "GT_TOWN_ROOT is deprecated; GT_ROOT not set"; result.Message != want {
+		t.Errorf("message = %q, want %q", result.Message, want)
+	}
+
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix() failed: %v", err)
+	}
+	if got := mock.env["GT_ROOT"]; got != ctx.TownRoot {
+		t.Errorf("GT_ROOT = %q after fix, want %q", got, ctx.TownRoot)
+	}
+	if got, ok := mock.env["GT_TOWN_ROOT"]; !ok || got != ctx.TownRoot {
+		t.Errorf("GT_TOWN_ROOT = %q (set=%v) after fix, want it kept as %q", got, ok, ctx.TownRoot)
+	}
+
+	result = check.Run(ctx)
 	if result.Status != StatusOK {
-		t.Errorf("expected StatusOK when GT_TOWN_ROOT correct, got %v: %s", result.Status, result.Message)
+		t.Errorf("expected StatusOK after fix, got %v: %s", result.Status, result.Message)
 	}
 }
 
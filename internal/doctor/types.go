@@ -2,10 +2,14 @@
 package doctor
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/ui"
 )
 
@@ -57,6 +61,46 @@ func (s CheckStatus) String() string {
 	}
 }
 
+// statusNames are the JSON and --filter spellings of each CheckStatus.
+var statusNames = map[CheckStatus]string{
+	StatusOK:      "ok",
+	StatusWarning: "warning",
+	StatusError:   "error",
+}
+
+// ParseCheckStatus parses "ok", "warning" or "error" (case-insensitive).
+func ParseCheckStatus(s string) (CheckStatus, error) {
+	for status, name := range statusNames {
+		if strings.EqualFold(s, name) {
+			return status, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown check status %q (want ok, warning, or error)", s)
+}
+
+// MarshalJSON encodes the status as "ok", "warning" or "error".
+func (s CheckStatus) MarshalJSON() ([]byte, error) {
+	name, ok := statusNames[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown check status %d", int(s))
+	}
+	return json.Marshal(name)
+}
+
+// UnmarshalJSON decodes a status written by MarshalJSON.
+func (s *CheckStatus) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	status, err := ParseCheckStatus(name)
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
+
 // CheckContext provides context for running checks.
 type CheckContext struct {
 	TownRoot        string // Root directory of the Gas Town workspace
@@ -64,6 +108,18 @@ type CheckContext struct {
 	Verbose         bool   // Enable verbose output
 	RestartSessions bool   // Restart patrol sessions when fixing (requires explicit --restart-sessions flag)
 	NoStart         bool   // Suppress starting daemon/agents during --fix
+
+	// StopOnCategory, when set, runs checks in CategoryOrder and skips every
+	// check in a later category once a check in this category reports an
+	// error. Matched case-insensitively (e.g. "infrastructure").
+	StopOnCategory string
+
+	// Environment shared by checks, filled in by DetectEnvironment (see
+	// NewCheckContext). Until then tmux-dependent checks always run.
+	Accounts      *config.AccountsConfig // mayor/accounts.json, nil if absent or unloadable
+	TmuxAvailable bool                   // a tmux server is running
+	DaemonRunning bool                   // the town daemon is running
+	envDetected   bool
 }
 
 // RigPath returns the full path to the rig directory.
@@ -80,14 +136,15 @@ const DefaultSlowThreshold = 1 * time.Second
 
 // CheckResult represents the outcome of a health check.
 type CheckResult struct {
-	Name     string        // Check name
-	Status   CheckStatus   // Result status
-	Message  string        // Primary result message
-	Details  []string      // Additional information
-	FixHint  string        // Suggestion if not auto-fixable
-	Category string        // Category for grouping (e.g., CategoryCore)
-	Elapsed  time.Duration // How long the check took to run
-	Fixed    bool          // True if this check was auto-fixed
+	Name     string        `json:"name"`                // Check name
+	Status   CheckStatus   `json:"status"`              // Result status
+	Message  string        `json:"message"`             // Primary result message
+	Details  []string      `json:"details,omitempty"`   // Additional information
+	FixHint  string        `json:"fix_hint,omitempty"`  // Suggestion if not auto-fixable
+	Category string        `json:"category,omitempty"`  // Category for grouping (e.g., CategoryCore)
+	Elapsed  time.Duration `json:"elapsed_ns"`          // How long the check took to run
+	Fixed    bool          `json:"fixed,omitempty"`     // True if this check was auto-fixed
+	FixError string        `json:"fix_error,omitempty"` // Why an attempted auto-fix did not succeed
 }
 
 // Check defines the interface for a health check.
@@ -162,6 +219,20 @@ func (r *Report) Add(result *CheckResult) {
 	}
 }
 
+// WriteJSON writes the check results as an indented JSON array. When
+// statuses are given, only results with one of those statuses are written.
+func (r *Report) WriteJSON(w io.Writer, statuses ...CheckStatus) error {
+	results := make([]*CheckResult, 0, len(r.Checks))
+	for _, result := range r.Checks {
+		if len(statuses) == 0 || slices.Contains(statuses, result.Status) {
+			results = append(results, result)
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(results)
+}
+
 // HasErrors returns true if any check reported an error.
 func (r *Report) HasErrors() bool {
 	return r.Summary.Errors > 0
@@ -332,6 +403,29 @@ func formatDuration(d time.Duration) string {
 	return fmt.Sprintf("%dh %dm", h, m)
 }
 
+// FixAttempts returns the checks whose auto-fix was attempted, whether or
+// not it succeeded.
+func (r *Report) FixAttempts() []*CheckResult {
+	var attempts []*CheckResult
+	for _, check := range r.Checks {
+		if check.Fixed || check.FixError != "" {
+			attempts = append(attempts, check)
+		}
+	}
+	return attempts
+}
+
+// PrintFixes outputs one line per attempted auto-fix.
+func (r *Report) PrintFixes(w io.Writer) {
+	for _, check := range r.FixAttempts() {
+		if check.Fixed {
+			_, _ = fmt.Fprintf(w, "  %s fixed %s\n", ui.RenderPassIcon(), check.Name)
+		} else {
+			_, _ = fmt.Fprintf(w, "  %s failed to fix %s: %s\n", ui.RenderFailIcon(), check.Name, check.FixError)
+		}
+	}
+}
+
 // printSummary outputs the summary line with semantic icons.
 func (r *Report) printSummary(w io.Writer, slowThreshold time.Duration) {
 	summary := fmt.Sprintf("%s %d passed  %s %d warnings  %s %d failed",
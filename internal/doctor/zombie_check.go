@@ -29,6 +29,10 @@ func NewZombieSessionCheck() *ZombieSessionCheck {
 	}
 }
 
+// RequiresTmux is true: a zombie is a tmux session whose agent has died, so
+// there are none to find without a tmux server.
+func (c *ZombieSessionCheck) RequiresTmux() bool { return true }
+
 // Run checks for zombie Gas Town sessions (tmux alive but Claude dead).
 func (c *ZombieSessionCheck) Run(ctx *CheckContext) *CheckResult {
 	t := tmux.NewTmux()
@@ -890,6 +890,64 @@ func (g *Git) runMergeCheck(args ...string) (string, error) {
 	return strings.TrimSpace(stdout.String()), nil
 }
 
+// FileStatus is the kind of change git diff --name-status reports for a file.
+type FileStatus string
+
+const (
+	FileAdded    FileStatus = "A"
+	FileModified FileStatus = "M"
+	FileDeleted  FileStatus = "D"
+	FileRenamed  FileStatus = "R"
+	FileCopied   FileStatus = "C"
+)
+
+// FileChange is one entry of FilesChangedSince. OldPath is set only for
+// renames and copies and holds the source path.
+type FileChange struct {
+	Path    string
+	OldPath string
+	Status  FileStatus
+}
+
+// FilesChangedSince returns the files changed on head since it diverged
+// from base (git diff base...head), with renames detected.
+func (g *Git) FilesChangedSince(base, head string) ([]FileChange, error) {
+	out, err := g.run("diff", "--name-status", "-M", "-z", base+"..."+head)
+	if err != nil {
+		return nil, err
+	}
+	return parseNameStatus(out)
+}
+
+// parseNameStatus parses NUL-separated git diff --name-status -z output:
+// "<status>\0<path>\0" per file, or "<status><score>\0<old>\0<new>\0" for
+// renames and copies.
+func parseNameStatus(out string) ([]FileChange, error) {
+	fields := strings.Split(strings.TrimRight(out, "\x00"), "\x00")
+	var changes []FileChange
+	for i := 0; i < len(fields); i++ {
+		code := fields[i]
+		if code == "" {
+			continue
+		}
+		status := FileStatus(code[:1])
+		if status == FileRenamed || status == FileCopied {
+			if i+2 >= len(fields) {
+				return nil, fmt.Errorf("truncated name-status entry %q", code)
+			}
+			changes = append(changes, FileChange{Path: fields[i+2], OldPath: fields[i+1], Status: status})
+			i += 2
+			continue
+		}
+		if i+1 >= len(fields) {
+			return nil, fmt.Errorf("truncated name-status entry %q", code)
+		}
+		changes = append(changes, FileChange{Path: fields[i+1], Status: status})
+		i++
+	}
+	return changes, nil
+}
+
 // GetConflictingFiles returns the list of files with merge conflicts.
 // ZFC: Uses git's porcelain output (diff --diff-filter=U) instead of parsing stderr.
 // This is the proper way to detect conflicts without violating ZFC.
@@ -946,6 +1004,45 @@ func (g *Git) BranchExists(name string) (bool, error) {
 	return true, nil
 }
 
+// RefErrorKind says why RefExistsDetail found no ref.
+type RefErrorKind int
+
+const (
+	// RefErrorUnknown: the ref is missing and the cause can't be narrowed down.
+	RefErrorUnknown RefErrorKind = iota
+	// RefErrorNoRemote: the ref names a remote (e.g. origin/main) that is not
+	// configured in the repository, and no local branch uses that name.
+	RefErrorNoRemote
+	// RefErrorBranchNotFound: the remote is configured (or the ref is local)
+	// but the branch does not exist.
+	RefErrorBranchNotFound
+)
+
+// RefError is returned by RefExistsDetail when the ref does not exist.
+type RefError struct {
+	Ref  string
+	Kind RefErrorKind
+}
+
+func (e *RefError) Error() string {
+	switch e.Kind {
+	case RefErrorNoRemote:
+		remote, _, _ := strings.Cut(strings.TrimPrefix(e.Ref, "refs/remotes/"), "/")
+		return fmt.Sprintf("ref %s not found: remote %q is not configured", e.Ref, remote)
+	case RefErrorBranchNotFound:
+		return fmt.Sprintf("ref %s not found: branch does not exist", e.Ref)
+	default:
+		return fmt.Sprintf("ref %s not found", e.Ref)
+	}
+}
+
+// IsRefNotFound reports whether err is a *RefError, i.e. RefExistsDetail
+// ran successfully and the ref is missing.
+func IsRefNotFound(err error) bool {
+	var refErr *RefError
+	return errors.As(err, &refErr)
+}
+
 // RefExists checks if a ref exists (works for any ref including origin/<branch>).
 // Uses show-ref for fully-qualified refs, falls back to rev-parse for short refs.
 func (g *Git) RefExists(ref string) (bool, error) {
@@ -977,6 +1074,63 @@ func (g *Git) RefExists(ref string) (bool, error) {
 	return true, nil
 }
 
+// RefExistsDetail is like RefExists, but a missing ref returns false with a
+// *RefError saying why. Other errors mean the check itself failed.
+func (g *Git) RefExistsDetail(ref string) (bool, error) {
+	exists, err := g.RefExists(ref)
+	if err != nil || exists {
+		return exists, err
+	}
+	return false, g.missingRefError(ref)
+}
+
+// missingRefError classifies a ref that RefExists did not find.
+func (g *Git) missingRefError(ref string) *RefError {
+	switch {
+	case strings.HasPrefix(ref, "refs/heads/"):
+		return &RefError{Ref: ref, Kind: RefErrorBranchNotFound}
+	case strings.HasPrefix(ref, "refs/remotes/"):
+		remote, _, _ := strings.Cut(strings.TrimPrefix(ref, "refs/remotes/"), "/")
+		return &RefError{Ref: ref, Kind: g.remoteRefKind(remote)}
+	case strings.HasPrefix(ref, "refs/"):
+		return &RefError{Ref: ref, Kind: RefErrorUnknown}
+	}
+	remote, _, ok := strings.Cut(ref, "/")
+	if !ok {
+		return &RefError{Ref: ref, Kind: RefErrorBranchNotFound}
+	}
+	// A short ref like "feature/x" may be a local branch rather than a
+	// remote-tracking ref. Only blame the remote when no local branch lives
+	// under that name either.
+	kind := g.remoteRefKind(remote)
+	if kind == RefErrorNoRemote && g.hasLocalBranchesUnder(remote) {
+		kind = RefErrorBranchNotFound
+	}
+	return &RefError{Ref: ref, Kind: kind}
+}
+
+// remoteRefKind returns RefErrorBranchNotFound if remote is configured and
+// RefErrorNoRemote otherwise.
+func (g *Git) remoteRefKind(remote string) RefErrorKind {
+	out, err := g.run("remote")
+	if err != nil {
+		return RefErrorUnknown
+	}
+	for _, name := range strings.Split(out, "\n") {
+		if name == remote {
+			return RefErrorBranchNotFound
+		}
+	}
+	return RefErrorNoRemote
+}
+
+// hasLocalBranchesUnder reports whether refs/heads/<prefix> is a branch or
+// holds branches (refs/heads/<prefix>/...).
+func (g *Git) hasLocalBranchesUnder(prefix string) bool {
+	out, err := g.run("for-each-ref", "--count=1", "--format=%(refname)", "refs/heads/"+prefix)
+	return err == nil && strings.TrimSpace(out) != ""
+}
+
 // IsEmpty returns true if the repository has no refs (an empty/unborn repo).
 // This is the case for newly-created repos with no commits.
 func (g *Git) IsEmpty() (bool, error) {
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -625,6 +626,59 @@ func TestRefExists_InvalidRef(t *testing.T) {
 	if exists {
 		t.Error("expected nonexistent ref to not exist")
 	}
+
+	exists, err = g.RefExistsDetail("refs/heads/nonexistent-branch")
+	var refErr *RefError
+	if !errors.As(err, &refErr) {
+		t.Fatalf("RefExistsDetail: err = %v, want *RefError", err)
+	}
+	if refErr.Kind != RefErrorBranchNotFound {
+		t.Errorf("Kind = %v, want RefErrorBranchNotFound", refErr.Kind)
+	}
+	if exists {
+		t.Error("expected nonexistent ref to not exist")
+	}
+}
+
+func TestRefExists_NoRemote(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	// No remote is configured, so origin/main can't exist.
+	for _, ref := range []string{"origin/main", "refs/remotes/origin/main"} {
+		exists, err := g.RefExistsDetail(ref)
+		if exists {
+			t.Errorf("RefExistsDetail(%s) = true in repo without remotes", ref)
+		}
+		var refErr *RefError
+		if !errors.As(err, &refErr) {
+			t.Fatalf("RefExistsDetail(%s): err = %v, want *RefError", ref, err)
+		}
+		if refErr.Kind != RefErrorNoRemote {
+			t.Errorf("RefExistsDetail(%s): Kind = %v, want RefErrorNoRemote", ref, refErr.Kind)
+		}
+		if !IsRefNotFound(err) {
+			t.Errorf("IsRefNotFound(%v) = false", err)
+		}
+	}
+}
+
+func TestRefExistsDetail_LocalBranchNamespace(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+	cmd := exec.Command("git", "branch", "feature/a")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git branch: %v: %s", err, out)
+	}
+
+	// "feature" is not a remote, but local branches live under it, so a
+	// missing feature/b is a missing branch, not a missing remote.
+	_, err := g.RefExistsDetail("feature/b")
+	var refErr *RefError
+	if !errors.As(err, &refErr) || refErr.Kind != RefErrorBranchNotFound {
+		t.Errorf("RefExistsDetail(feature/b): err = %v, want RefErrorBranchNotFound", err)
+	}
 }
 
 func TestRefExists_OriginRef(t *testing.T) {
@@ -687,8 +741,13 @@ func TestRefExists_OriginRef(t *testing.T) {
 
 	// origin/nonexistent should not exist
 	exists, err = bareGit.RefExists("origin/nonexistent")
-	if err != nil {
-		t.Fatalf("RefExists(origin/nonexistent): %v", err)
+	if err != nil || exists {
+		t.Fatalf("RefExists(origin/nonexistent) = %v, %v; want false, nil", exists, err)
+	}
+	exists, err = bareGit.RefExistsDetail("origin/nonexistent")
+	var refErr *RefError
+	if !errors.As(err, &refErr) || refErr.Kind != RefErrorBranchNotFound {
+		t.Fatalf("RefExistsDetail(origin/nonexistent): err = %v, want RefErrorBranchNotFound", err)
 	}
 	if exists {
 		t.Error("expected origin/nonexistent to not exist")
@@ -2131,3 +2190,68 @@ func TestBranchPushedToRemote_NoPushURL(t *testing.T) {
 		t.Errorf("BranchPushedToRemote unpushed = %d, want >= 1", unpushed)
 	}
 }
+
+func TestFilesChangedSince(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	// Base commit with files to modify, delete and rename.
+	write("modify.txt", "before\n")
+	write("delete.txt", "gone soon\n")
+	write("old-name.txt", "a file with enough content to be detected as a rename\n")
+	run("add", ".")
+	run("commit", "-m", "base")
+	run("tag", "base")
+
+	write("modify.txt", "after\n")
+	write("added.txt", "new\n")
+	run("rm", "-q", "delete.txt")
+	run("mv", "old-name.txt", "new name.txt")
+	run("add", ".")
+	run("commit", "-m", "changes")
+
+	changes, err := g.FilesChangedSince("base", "HEAD")
+	if err != nil {
+		t.Fatalf("FilesChangedSince: %v", err)
+	}
+
+	got := make(map[string]FileChange, len(changes))
+	for _, c := range changes {
+		got[c.Path] = c
+	}
+	want := []FileChange{
+		{Path: "added.txt", Status: FileAdded},
+		{Path: "modify.txt", Status: FileModified},
+		{Path: "delete.txt", Status: FileDeleted},
+		{Path: "new name.txt", OldPath: "old-name.txt", Status: FileRenamed},
+	}
+	if len(changes) != len(want) {
+		t.Errorf("got %d changes %+v, want %d", len(changes), changes, len(want))
+	}
+	for _, w := range want {
+		if got[w.Path] != w {
+			t.Errorf("change for %s = %+v, want %+v", w.Path, got[w.Path], w)
+		}
+	}
+}
+
+func TestParseNameStatus_Truncated(t *testing.T) {
+	if _, err := parseNameStatus("R100\x00old.txt\x00"); err == nil {
+		t.Error("expected error for truncated rename entry")
+	}
+}
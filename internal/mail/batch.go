@@ -0,0 +1,109 @@
+package mail
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// BeadsExecutor sends a single message through beads (bd mail send).
+// *Router satisfies it; tests substitute a fake.
+type BeadsExecutor interface {
+	Send(msg *Message) error
+}
+
+// BatchSendResult maps each message ID to its send error, or nil on success.
+type BatchSendResult map[string]error
+
+// Failed returns the number of messages that could not be sent.
+func (r BatchSendResult) Failed() int {
+	n := 0
+	for _, err := range r {
+		if err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// BatchSend sends each message with executor. A failed send is recorded in
+// the result and does not stop the remaining messages. Messages without an
+// ID are given one so every message has a result entry.
+func BatchSend(messages []*Message, executor BeadsExecutor) BatchSendResult {
+	result := make(BatchSendResult, len(messages))
+	for _, msg := range messages {
+		if msg.ID == "" {
+			msg.ID = GenerateID()
+		}
+		// Send may replace msg.ID with the delivered bead ID; key the
+		// result by the final ID.
+		err := executor.Send(msg)
+		result[msg.ID] = err
+	}
+	return result
+}
+
+// ParseBatch reads messages from JSONL, one Message object per line, for
+// BatchSend. Blank lines are skipped. Each message needs a recipient
+// ("to") and a subject; ID, thread, timestamp, priority and type are filled
+// in when absent. Every message is sent as from: like a single send, the
+// sender is never taken from the input, so a "from" field is rejected.
+// Duplicate IDs and unknown priorities are rejected as well.
+func ParseBatch(r io.Reader, from string) ([]*Message, error) {
+	var messages []*Message
+	seen := make(map[string]int)
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	line := 0
+	for sc.Scan() {
+		line++
+		text := strings.TrimSpace(sc.Text())
+		if text == "" {
+			continue
+		}
+		var msg Message
+		if err := json.Unmarshal([]byte(text), &msg); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if msg.To == "" {
+			return nil, fmt.Errorf("line %d: missing \"to\"", line)
+		}
+		if msg.Subject == "" {
+			return nil, fmt.Errorf("line %d: missing \"subject\"", line)
+		}
+		if msg.From != "" {
+			return nil, fmt.Errorf("line %d: \"from\" is not allowed; batch messages are sent as %s", line, from)
+		}
+		msg.From = from
+		if msg.ID == "" {
+			msg.ID = GenerateID()
+		} else if prev, ok := seen[msg.ID]; ok {
+			return nil, fmt.Errorf("line %d: duplicate id %q (first used on line %d)", line, msg.ID, prev)
+		}
+		seen[msg.ID] = line
+		if msg.ThreadID == "" {
+			msg.ThreadID = generateThreadID()
+		}
+		if msg.Timestamp.IsZero() {
+			msg.Timestamp = time.Now()
+		}
+		switch msg.Priority {
+		case "":
+			msg.Priority = PriorityNormal
+		case PriorityLow, PriorityNormal, PriorityHigh, PriorityUrgent:
+		default:
+			return nil, fmt.Errorf("line %d: invalid priority %q (want low, normal, high or urgent)", line, msg.Priority)
+		}
+		if msg.Type == "" {
+			msg.Type = TypeNotification
+		}
+		messages = append(messages, &msg)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
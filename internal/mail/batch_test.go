@@ -0,0 +1,102 @@
+package mail
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type fakeExecutor struct {
+	fail map[string]bool // recipient → fail
+	sent []string
+}
+
+func (f *fakeExecutor) Send(msg *Message) error {
+	if f.fail[msg.To] {
+		return errors.New("bd mail send failed")
+	}
+	f.sent = append(f.sent, msg.To)
+	return nil
+}
+
+func TestBatchSend_ContinuesAfterFailure(t *testing.T) {
+	msgs := []*Message{
+		NewMessage("mayor/", "gastown/Toast", "one", ""),
+		NewMessage("mayor/", "gastown/Nux", "two", ""),
+		NewMessage("mayor/", "gastown/Slit", "three", ""),
+	}
+	exec := &fakeExecutor{fail: map[string]bool{"gastown/Nux": true}}
+
+	result := BatchSend(msgs, exec)
+
+	if len(result) != 3 {
+		t.Fatalf("got %d results, want 3", len(result))
+	}
+	if err := result[msgs[0].ID]; err != nil {
+		t.Errorf("first message error = %v, want nil", err)
+	}
+	if err := result[msgs[1].ID]; err == nil {
+		t.Error("second message error = nil, want failure")
+	}
+	if err := result[msgs[2].ID]; err != nil {
+		t.Errorf("third message error = %v, want nil", err)
+	}
+	if got := strings.Join(exec.sent, ","); got != "gastown/Toast,gastown/Slit" {
+		t.Errorf("sent = %s, want first and third", got)
+	}
+	if result.Failed() != 1 {
+		t.Errorf("Failed() = %d, want 1", result.Failed())
+	}
+}
+
+func TestBatchSend_AssignsMissingIDs(t *testing.T) {
+	msg := &Message{To: "mayor/", Subject: "no id"}
+	result := BatchSend([]*Message{msg}, &fakeExecutor{})
+	if msg.ID == "" {
+		t.Fatal("expected BatchSend to assign an ID")
+	}
+	if _, ok := result[msg.ID]; !ok {
+		t.Errorf("result missing entry for %s", msg.ID)
+	}
+}
+
+func TestParseBatch(t *testing.T) {
+	input := `{"to":"gastown/Toast","subject":"hi","body":"first"}
+
+{"to":"mayor/","subject":"status","priority":"high","id":"msg-1"}
+`
+	msgs, err := ParseBatch(strings.NewReader(input), "overseer")
+	if err != nil {
+		t.Fatalf("ParseBatch: %v", err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("got %d messages, want 2", len(msgs))
+	}
+	if msgs[0].From != "overseer" || msgs[0].Priority != PriorityNormal || msgs[0].ID == "" || msgs[0].ThreadID == "" {
+		t.Errorf("defaults not applied: %+v", msgs[0])
+	}
+	if msgs[1].From != "overseer" || msgs[1].Priority != PriorityHigh || msgs[1].ID != "msg-1" {
+		t.Errorf("explicit fields overwritten: %+v", msgs[1])
+	}
+
+	if _, err := ParseBatch(strings.NewReader(`{"subject":"x"}`), "overseer"); err == nil || !strings.Contains(err.Error(), "line 1") {
+		t.Errorf("missing recipient error = %v, want line 1 error", err)
+	}
+}
+
+func TestParseBatch_Rejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"spoofed sender", `{"to":"mayor/","subject":"x","from":"gastown/witness"}`, `"from" is not allowed`},
+		{"duplicate id", `{"to":"mayor/","subject":"a","id":"m1"}` + "\n" + `{"to":"mayor/","subject":"b","id":"m1"}`, "line 2: duplicate id"},
+		{"bad priority", `{"to":"mayor/","subject":"x","priority":"asap"}`, "invalid priority"},
+	}
+	for _, tt := range tests {
+		if _, err := ParseBatch(strings.NewReader(tt.input), "overseer"); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: err = %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
@@ -2,8 +2,11 @@ package mail
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -162,3 +165,73 @@ func ParseDeliveryLabels(labels []string) (state, ackedBy string, ackedAt *time.
 	}
 	return "", "", nil
 }
+
+// ErrDeliveryTimeout is returned by AwaitDelivery when the message is still
+// open and unread once the timeout expires.
+var ErrDeliveryTimeout = errors.New("timed out waiting for delivery")
+
+// awaitDeliveryPollInterval is how often AwaitDelivery re-reads the message
+// bead. It can be overridden in tests.
+var awaitDeliveryPollInterval = 2 * time.Second
+
+// AwaitDelivery polls `bd show <msgID>` until the recipient has read the
+// message — it carries the "read" label (gt mail mark-read, MarkReadOnly) or
+// has left the open state (read via gt mail read, or archived) — or timeout
+// expires. msgID must be a bead ID, as set on Message.ID by a successful
+// direct Send.
+func (r *Router) AwaitDelivery(msgID string, timeout time.Duration) error {
+	beadsDir := beads.ResolveBeadsDirForID(r.resolveBeadsDir(), msgID)
+	deadline := time.Now().Add(timeout)
+	for {
+		bm, err := showMessageBead(filepath.Dir(beadsDir), beadsDir, msgID)
+		if err != nil {
+			return err
+		}
+		if bm.Status != "open" || slices.Contains(bm.Labels, "read") {
+			return nil
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("%s: %w", msgID, ErrDeliveryTimeout)
+		}
+		time.Sleep(min(awaitDeliveryPollInterval, remaining))
+	}
+}
+
+// showMessageBead returns a single message bead via bd show --json.
+func showMessageBead(workDir, beadsDir, id string) (*BeadsMessage, error) {
+	ctx, cancel := bdReadCtx()
+	defer cancel()
+	stdout, err := runBdCommand(ctx, []string{"show", id, "--json"}, workDir, beadsDir)
+	if err != nil {
+		if bdErr, ok := err.(*bdError); ok && bdErr.ContainsError("not found") {
+			return nil, ErrMessageNotFound
+		}
+		return nil, fmt.Errorf("bd show %s: %w", id, err)
+	}
+	var bms []BeadsMessage
+	if err := json.Unmarshal(stdout, &bms); err != nil {
+		return nil, fmt.Errorf("parsing bd show %s: %w", id, err)
+	}
+	if len(bms) == 0 {
+		return nil, ErrMessageNotFound
+	}
+	return &bms[0], nil
+}
+
+// parseCreatedBeadID extracts the new bead ID from `bd create --json`
+// output. Older bd versions print the bare ID instead; anything else
+// yields "".
+func parseCreatedBeadID(out []byte) string {
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(out, &created); err == nil {
+		return created.ID
+	}
+	id := strings.TrimSpace(string(out))
+	if id == "" || strings.ContainsAny(id, " \t\n") {
+		return ""
+	}
+	return id
+}
@@ -1,7 +1,12 @@
 package mail
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
 	"reflect"
+	"runtime"
+	"strings"
 	"testing"
 	"time"
 )
@@ -180,3 +185,141 @@ func TestDeliveryAckLabelSequenceIdempotent(t *testing.T) {
 		}
 	})
 }
+
+// writeStatusBdStub installs a bd stub whose `show` reports each status in
+// turn (repeating the last) and returns the file counting show calls.
+func writeStatusBdStub(t *testing.T, statuses ...string) string {
+	t.Helper()
+	binDir := t.TempDir()
+	countFile := filepath.Join(binDir, "show-count")
+	script := `#!/usr/bin/env bash
+set -euo pipefail
+if [[ "${1:-}" != "show" ]]; then
+  echo "unsupported bd args: $*" >&2
+  exit 1
+fi
+n=$(( $(cat "` + countFile + `" 2>/dev/null || echo 0) + 1 ))
+echo "$n" > "` + countFile + `"
+statuses=(` + strings.Join(statuses, " ") + `)
+i=$(( n <= ${#statuses[@]} ? n - 1 : ${#statuses[@]} - 1 ))
+echo "[{\"id\":\"$2\",\"status\":\"${statuses[$i]}\"}]"
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return countFile
+}
+
+func showCount(t *testing.T, countFile string) string {
+	t.Helper()
+	data, err := os.ReadFile(countFile)
+	if err != nil {
+		t.Fatalf("read show count: %v", err)
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func TestAwaitDelivery_ReturnsWhenClosed(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a bash script")
+	}
+	countFile := writeStatusBdStub(t, "open", "open", "closed")
+	old := awaitDeliveryPollInterval
+	awaitDeliveryPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { awaitDeliveryPollInterval = old })
+
+	townRoot := t.TempDir()
+	r := NewRouterWithTownRoot(townRoot, townRoot)
+	if err := r.AwaitDelivery("hq-wisp-abc", 5*time.Second); err != nil {
+		t.Fatalf("AwaitDelivery: %v", err)
+	}
+	if got := showCount(t, countFile); got != "3" {
+		t.Errorf("bd show called %s times, want 3", got)
+	}
+}
+
+func TestAwaitDelivery_Timeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a bash script")
+	}
+	writeStatusBdStub(t, "open")
+	old := awaitDeliveryPollInterval
+	awaitDeliveryPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { awaitDeliveryPollInterval = old })
+
+	townRoot := t.TempDir()
+	r := NewRouterWithTownRoot(townRoot, townRoot)
+	err := r.AwaitDelivery("hq-wisp-abc", 50*time.Millisecond)
+	if !errors.Is(err, ErrDeliveryTimeout) {
+		t.Fatalf("AwaitDelivery = %v, want ErrDeliveryTimeout", err)
+	}
+}
+
+func TestAwaitDelivery_ReturnsWhenMarkedRead(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("bd stub is a bash script")
+	}
+	// The stub keeps the bead open; `bd label add <id> read` records the
+	// label, which later `bd show` calls report.
+	binDir := t.TempDir()
+	labelFile := filepath.Join(binDir, "labels")
+	script := `#!/usr/bin/env bash
+set -euo pipefail
+case "${1:-}" in
+  label)
+    [[ "${2:-}" == "add" ]] && echo "$4" > "` + labelFile + `"
+    ;;
+  show)
+    labels="[]"
+    [[ -f "` + labelFile + `" ]] && labels="[\"$(cat "` + labelFile + `")\"]"
+    echo "[{\"id\":\"$2\",\"status\":\"open\",\"labels\":$labels}]"
+    ;;
+  *)
+    echo "unsupported bd args: $*" >&2
+    exit 1
+    ;;
+esac
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	old := awaitDeliveryPollInterval
+	awaitDeliveryPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { awaitDeliveryPollInterval = old })
+
+	townRoot := t.TempDir()
+	r := NewRouterWithTownRoot(townRoot, townRoot)
+	if err := r.AwaitDelivery("hq-wisp-abc", 50*time.Millisecond); !errors.Is(err, ErrDeliveryTimeout) {
+		t.Fatalf("AwaitDelivery before read = %v, want ErrDeliveryTimeout", err)
+	}
+
+	mb, err := r.GetMailbox("mayor/")
+	if err != nil {
+		t.Fatalf("GetMailbox: %v", err)
+	}
+	if err := mb.MarkReadOnly("hq-wisp-abc"); err != nil {
+		t.Fatalf("MarkReadOnly: %v", err)
+	}
+	if err := r.AwaitDelivery("hq-wisp-abc", 5*time.Second); err != nil {
+		t.Fatalf("AwaitDelivery after MarkReadOnly: %v", err)
+	}
+}
+
+func TestParseCreatedBeadID(t *testing.T) {
+	tests := []struct {
+		out  string
+		want string
+	}{
+		{`{"id":"hq-wisp-abc","title":"hi"}`, "hq-wisp-abc"},
+		{"hq-testmail-1\n", "hq-testmail-1"},
+		{"✓ Created issue: hq-abc\n", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := parseCreatedBeadID([]byte(tt.out)); got != tt.want {
+			t.Errorf("parseCreatedBeadID(%q) = %q, want %q", tt.out, got, tt.want)
+		}
+	}
+}
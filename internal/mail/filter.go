@@ -0,0 +1,115 @@
+package mail
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MailFilter selects messages by sender, recipient, subject, priority and
+// read state. Set fields are ANDed together; zero-valued fields match
+// every message.
+type MailFilter struct {
+	From     string   // exact sender address
+	To       string   // exact recipient address
+	Subject  string   // case-insensitive substring of the subject
+	Priority Priority // exact priority
+	Unread   *bool    // nil ignores read state
+}
+
+// Matches reports whether msg satisfies every set field of f.
+func (f MailFilter) Matches(msg *Message) bool {
+	if f.From != "" && msg.From != f.From {
+		return false
+	}
+	if f.To != "" && msg.To != f.To {
+		return false
+	}
+	if f.Subject != "" && !strings.Contains(strings.ToLower(msg.Subject), strings.ToLower(f.Subject)) {
+		return false
+	}
+	if f.Priority != "" && msg.Priority != f.Priority {
+		return false
+	}
+	if f.Unread != nil && msg.Read == *f.Unread {
+		return false
+	}
+	return true
+}
+
+// FilterMessages returns the messages matching f, in their original order.
+func FilterMessages(messages []*Message, f MailFilter) []*Message {
+	var out []*Message
+	for _, msg := range messages {
+		if f.Matches(msg) {
+			out = append(out, msg)
+		}
+	}
+	return out
+}
+
+// ArchiveReadMessages sets Archived on every read message sent more than
+// olderThan ago and returns the messages it archived. Unread and pinned
+// messages are never archived.
+func ArchiveReadMessages(messages []*Message, olderThan time.Duration) []*Message {
+	cutoff := timeNow().Add(-olderThan)
+	var archived []*Message
+	for _, msg := range messages {
+		if !msg.Read || msg.Pinned || msg.Archived || !msg.Timestamp.Before(cutoff) {
+			continue
+		}
+		msg.Archived = true
+		archived = append(archived, msg)
+	}
+	return archived
+}
+
+// PurgeArchivedMessages returns messages without the archived ones, in their
+// original order.
+func PurgeArchivedMessages(messages []*Message) []*Message {
+	var out []*Message
+	for _, msg := range messages {
+		if !msg.Archived {
+			out = append(out, msg)
+		}
+	}
+	return out
+}
+
+// ErrBadPattern is returned by SearchMessages and Mailbox.Search when a
+// regex query does not compile.
+var ErrBadPattern = errors.New("invalid search pattern")
+
+// searchPattern compiles query for case-insensitive matching, as a regular
+// expression when useRegex is set and as a literal substring otherwise.
+func searchPattern(query string, useRegex bool) (*regexp.Regexp, error) {
+	if !useRegex {
+		query = regexp.QuoteMeta(query)
+	}
+	re, err := regexp.Compile("(?i)" + query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadPattern, err)
+	}
+	return re, nil
+}
+
+// SearchMessages returns the messages whose subject or body matches query,
+// newest first. query is a case-insensitive substring, or a regular
+// expression when useRegex is set.
+func SearchMessages(messages []*Message, query string, useRegex bool) ([]*Message, error) {
+	re, err := searchPattern(query, useRegex)
+	if err != nil {
+		return nil, err
+	}
+	var out []*Message
+	for _, msg := range messages {
+		if re.MatchString(msg.Subject) || re.MatchString(msg.Body) {
+			out = append(out, msg)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Timestamp.After(out[j].Timestamp) })
+	return out, nil
+}
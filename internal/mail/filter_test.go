@@ -0,0 +1,158 @@
+package mail
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func filterIDs(msgs []*Message) []string {
+	ids := make([]string, 0, len(msgs))
+	for _, m := range msgs {
+		ids = append(ids, m.ID)
+	}
+	return ids
+}
+
+func TestFilterMessages(t *testing.T) {
+	yes, no := true, false
+	msgs := []*Message{
+		{ID: "m1", From: "mayor/", To: "gastown/Toast", Subject: "Status check", Priority: PriorityHigh},
+		{ID: "m2", From: "gastown/witness", To: "gastown/Toast", Subject: "Nudge", Priority: PriorityNormal, Read: true},
+		{ID: "m3", From: "mayor/", To: "gastown/Nux", Subject: "Handoff STATUS", Priority: PriorityNormal},
+		{ID: "m4", From: "mayor/", To: "gastown/Toast", Subject: "Done", Priority: PriorityHigh, Read: true},
+	}
+
+	tests := []struct {
+		name   string
+		filter MailFilter
+		want   []string
+	}{
+		{"empty filter matches all", MailFilter{}, []string{"m1", "m2", "m3", "m4"}},
+		{"from", MailFilter{From: "mayor/"}, []string{"m1", "m3", "m4"}},
+		{"to", MailFilter{To: "gastown/Nux"}, []string{"m3"}},
+		{"subject is case-insensitive substring", MailFilter{Subject: "status"}, []string{"m1", "m3"}},
+		{"priority", MailFilter{Priority: PriorityHigh}, []string{"m1", "m4"}},
+		{"unread only", MailFilter{Unread: &yes}, []string{"m1", "m3"}},
+		{"read only", MailFilter{Unread: &no}, []string{"m2", "m4"}},
+		{"nil unread ignores read state", MailFilter{From: "mayor/", Unread: nil}, []string{"m1", "m3", "m4"}},
+		{"from and priority", MailFilter{From: "mayor/", Priority: PriorityHigh}, []string{"m1", "m4"}},
+		{"from, priority and unread", MailFilter{From: "mayor/", Priority: PriorityHigh, Unread: &yes}, []string{"m1"}},
+		{"no match", MailFilter{From: "mayor/", To: "gastown/Nux", Priority: PriorityHigh}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterIDs(FilterMessages(msgs, tt.filter))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestArchiveReadMessages(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	oldNow := timeNow
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = oldNow })
+
+	msgs := []*Message{
+		{ID: "unread-old", Timestamp: now.Add(-30 * 24 * time.Hour)},
+		{ID: "read-old", Read: true, Timestamp: now.Add(-8 * 24 * time.Hour)},
+		{ID: "read-recent", Read: true, Timestamp: now.Add(-2 * 24 * time.Hour)},
+		{ID: "pinned-old", Read: true, Pinned: true, Timestamp: now.Add(-30 * 24 * time.Hour)},
+	}
+
+	archived := ArchiveReadMessages(msgs, 7*24*time.Hour)
+	if got := filterIDs(archived); len(got) != 1 || got[0] != "read-old" {
+		t.Fatalf("archived = %v, want [read-old]", got)
+	}
+	for _, m := range msgs {
+		if m.Archived != (m.ID == "read-old") {
+			t.Errorf("%s Archived = %v", m.ID, m.Archived)
+		}
+	}
+
+	// Already-archived messages are not reported again.
+	if again := ArchiveReadMessages(msgs, 7*24*time.Hour); len(again) != 0 {
+		t.Errorf("second archive = %v, want none", filterIDs(again))
+	}
+}
+
+func TestPurgeArchivedMessages(t *testing.T) {
+	msgs := []*Message{
+		{ID: "m1"},
+		{ID: "m2", Archived: true, Read: true},
+		{ID: "m3", Read: true},
+		{ID: "m4", Archived: true},
+	}
+	got := filterIDs(PurgeArchivedMessages(msgs))
+	if len(got) != 2 || got[0] != "m1" || got[1] != "m3" {
+		t.Errorf("PurgeArchivedMessages = %v, want [m1 m3]", got)
+	}
+	if len(PurgeArchivedMessages(nil)) != 0 {
+		t.Error("PurgeArchivedMessages(nil) should be empty")
+	}
+}
+
+func TestSearchMessages(t *testing.T) {
+	base := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	msgs := []*Message{
+		{ID: "m1", Subject: "Deploy STATUS", Body: "all green", Timestamp: base},
+		{ID: "m2", Subject: "Review", Body: "please look at PR-142 and MR-7", Timestamp: base.Add(2 * time.Hour)},
+		{ID: "m3", Subject: "lunch", Body: "status of the sandwich order", Timestamp: base.Add(time.Hour)},
+		{ID: "m4", Subject: "Handoff", Body: "nothing to see", Timestamp: base.Add(3 * time.Hour)},
+	}
+
+	t.Run("substring is case-insensitive", func(t *testing.T) {
+		got, err := SearchMessages(msgs, "status", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids := filterIDs(got); len(ids) != 2 || ids[0] != "m3" || ids[1] != "m1" {
+			t.Errorf("got %v, want [m3 m1] (newest first)", ids)
+		}
+	})
+
+	t.Run("substring treats regex chars literally", func(t *testing.T) {
+		got, err := SearchMessages(msgs, "PR-1.2", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Errorf("got %v, want no matches", filterIDs(got))
+		}
+	})
+
+	t.Run("regex with capture groups", func(t *testing.T) {
+		got, err := SearchMessages(msgs, `(PR|MR)-(\d+)`, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids := filterIDs(got); len(ids) != 1 || ids[0] != "m2" {
+			t.Errorf("got %v, want [m2]", ids)
+		}
+	})
+
+	t.Run("invalid regex", func(t *testing.T) {
+		_, err := SearchMessages(msgs, `(unclosed`, true)
+		if !errors.Is(err, ErrBadPattern) {
+			t.Errorf("err = %v, want ErrBadPattern", err)
+		}
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		got, err := SearchMessages(msgs, "zebra", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 0 {
+			t.Errorf("got %v, want none", filterIDs(got))
+		}
+	})
+}
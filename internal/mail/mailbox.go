@@ -871,6 +871,17 @@ func (m *Mailbox) ArchivePath() string {
 	return filepath.Join(m.beadsDir, "archive.jsonl")
 }
 
+// lockSharedArchive serializes access to the beads-mode archive file, which
+// every mailbox using the same beads directory shares. Legacy archives are
+// covered by the mailbox lock instead.
+func (m *Mailbox) lockSharedArchive() (*flock.Flock, error) {
+	fl := flock.New(m.ArchivePath() + ".lock")
+	if err := fl.Lock(); err != nil {
+		return nil, fmt.Errorf("acquiring archive lock: %w", err)
+	}
+	return fl, nil
+}
+
 func (m *Mailbox) appendToArchive(msg *Message) error {
 	archivePath := m.ArchivePath()
 
@@ -880,6 +891,14 @@ func (m *Mailbox) appendToArchive(msg *Message) error {
 		return err
 	}
 
+	if !m.legacy {
+		fl, err := m.lockSharedArchive()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = fl.Unlock() }()
+	}
+
 	// Open for append
 	file, err := os.OpenFile(archivePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: archive is non-sensitive operational data
 	if err != nil {
@@ -887,7 +906,9 @@ func (m *Mailbox) appendToArchive(msg *Message) error {
 	}
 	defer func() { _ = file.Close() }()
 
-	data, err := json.Marshal(msg)
+	archived := *msg
+	archived.Archived = true
+	data, err := json.Marshal(&archived)
 	if err != nil {
 		return err
 	}
@@ -988,6 +1009,52 @@ func (m *Mailbox) PurgeArchive(olderThanDays int) (int, error) {
 	return purged, nil
 }
 
+// PurgeOwnArchive permanently deletes this mailbox's messages from the
+// archive file and returns how many it removed. A legacy archive belongs to
+// one mailbox and is emptied. The beads-mode archive is shared by every
+// mailbox on the same beads directory, so only messages addressed to this
+// mailbox's identity are removed and the file is rewritten with the rest.
+func (m *Mailbox) PurgeOwnArchive() (int, error) {
+	var fl *flock.Flock
+	var err error
+	if m.legacy {
+		fl, err = m.lockLegacy()
+	} else {
+		fl, err = m.lockSharedArchive()
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = fl.Unlock() }()
+
+	messages, err := m.ListArchived()
+	if err != nil {
+		return 0, err
+	}
+
+	var keep []*Message
+	purged := 0
+	for _, msg := range messages {
+		if m.legacy || AddressToIdentity(msg.To) == m.identity {
+			purged++
+		} else {
+			keep = append(keep, msg)
+		}
+	}
+	if purged == 0 {
+		return 0, nil
+	}
+
+	if len(keep) == 0 {
+		if err := os.Remove(m.ArchivePath()); err != nil && !os.IsNotExist(err) {
+			return 0, err
+		}
+	} else if err := m.rewriteArchive(keep); err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
 func (m *Mailbox) rewriteArchive(messages []*Message) error {
 	archivePath := m.ArchivePath()
 	tmpPath := archivePath + ".tmp"
@@ -1021,7 +1088,8 @@ func (m *Mailbox) rewriteArchive(messages []*Message) error {
 
 // SearchOptions specifies search parameters.
 type SearchOptions struct {
-	Query       string // Regex pattern to search for
+	Query       string // Text to search for (a regex pattern if Regex is set)
+	Regex       bool   // Treat Query as a regular expression
 	FromFilter  string // Optional: only match messages from this sender
 	SubjectOnly bool   // Only search subject
 	BodyOnly    bool   // Only search body
@@ -1029,13 +1097,12 @@ type SearchOptions struct {
 
 // Search finds messages matching the given criteria.
 // Returns messages from both inbox and archive.
-// Query and FromFilter are treated as literal strings (not regex) to prevent ReDoS.
+// Query is a literal string unless opts.Regex is set; an invalid regex
+// returns ErrBadPattern. FromFilter is always literal.
 func (m *Mailbox) Search(opts SearchOptions) ([]*Message, error) {
-	// Use QuoteMeta to escape special regex chars - prevents ReDoS attacks
-	// and provides intuitive literal string matching for users
-	re, err := regexp.Compile("(?i)" + regexp.QuoteMeta(opts.Query))
+	re, err := searchPattern(opts.Query, opts.Regex)
 	if err != nil {
-		return nil, fmt.Errorf("invalid search pattern: %w", err)
+		return nil, err
 	}
 
 	var fromRe *regexp.Regexp
@@ -611,6 +611,48 @@ func TestMailboxLegacyArchiveCorruptionDetection(t *testing.T) {
 	}
 }
 
+func TestMailboxPurgeOwnArchive_SharedArchive(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	toast := NewMailboxWithBeadsDir("gastown/Toast", tmpDir, beadsDir)
+	mayor := NewMailboxWithBeadsDir("mayor/", tmpDir, beadsDir)
+	if toast.ArchivePath() != mayor.ArchivePath() {
+		t.Fatalf("expected a shared archive, got %s and %s", toast.ArchivePath(), mayor.ArchivePath())
+	}
+
+	for _, msg := range []*Message{
+		{ID: "hq-1", To: "gastown/Toast", Subject: "toast one"},
+		{ID: "hq-2", To: "mayor/", Subject: "mayor"},
+		{ID: "hq-3", To: "gastown/Toast", Subject: "toast two"},
+	} {
+		if err := toast.appendToArchive(msg); err != nil {
+			t.Fatalf("appendToArchive: %v", err)
+		}
+	}
+
+	purged, err := toast.PurgeOwnArchive()
+	if err != nil {
+		t.Fatalf("PurgeOwnArchive: %v", err)
+	}
+	if purged != 2 {
+		t.Errorf("purged = %d, want 2", purged)
+	}
+	left, err := mayor.ListArchived()
+	if err != nil {
+		t.Fatalf("ListArchived: %v", err)
+	}
+	if len(left) != 1 || left[0].ID != "hq-2" {
+		t.Errorf("archive after toast purge = %+v, want only hq-2", left)
+	}
+
+	if purged, err := mayor.PurgeOwnArchive(); err != nil || purged != 1 {
+		t.Fatalf("mayor PurgeOwnArchive = %d, %v; want 1, nil", purged, err)
+	}
+	if _, err := os.Stat(mayor.ArchivePath()); !os.IsNotExist(err) {
+		t.Errorf("empty archive should be removed, stat err = %v", err)
+	}
+}
+
 func TestMailboxLegacyConcurrentMarkRead(t *testing.T) {
 	tmpDir := t.TempDir()
 	m := NewMailbox(tmpDir)
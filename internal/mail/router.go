@@ -189,8 +189,9 @@ func (r *Router) expandAnnounce(announceName string) (*config.AnnounceConfig, er
 //
 // Uses workspace.Find which correctly handles nested workspaces by always
 // searching to the filesystem root and returning the outermost workspace.
-// Falls back to GT_TOWN_ROOT/GT_ROOT env vars when workspace.Find cannot
-// locate a workspace (e.g., running from outside any workspace).
+// Falls back to the GT_ROOT env var (or legacy GT_TOWN_ROOT) when
+// workspace.Find cannot locate a workspace (e.g., running from outside any
+// workspace).
 func detectTownRoot(startDir string) string {
 	// workspace.Find handles nested workspaces correctly: it always searches
 	// to the filesystem root and returns the outermost mayor/town.json match.
@@ -199,13 +200,11 @@ func detectTownRoot(startDir string) string {
 		return townRoot
 	}
 
-	// Fallback: try GT_TOWN_ROOT or GT_ROOT env vars when workspace detection
-	// fails (e.g., running from outside any workspace directory).
-	for _, envName := range []string{"GT_TOWN_ROOT", "GT_ROOT"} {
-		if envRoot := os.Getenv(envName); envRoot != "" {
-			if ok, _ := workspace.IsWorkspace(envRoot); ok {
-				return envRoot
-			}
+	// Fallback: try the town root env var when workspace detection fails
+	// (e.g., running from outside any workspace directory).
+	if envRoot, _, _ := config.ResolveRootEnv(); envRoot != "" {
+		if ok, _ := workspace.IsWorkspace(envRoot); ok {
+			return envRoot
 		}
 	}
 	return ""
@@ -260,6 +259,14 @@ func isTownLevelAddress(address string) bool {
 	return addr == constants.RoleMayor || addr == constants.RoleDeacon || addr == "overseer"
 }
 
+// IsFanOutAddress reports whether Send delivers address as something other
+// than one message bead for one reader: list, queue, announce, channel, and
+// @group addresses.
+func IsFanOutAddress(address string) bool {
+	return isListAddress(address) || isQueueAddress(address) || isAnnounceAddress(address) ||
+		isChannelAddress(address) || isGroupAddress(address)
+}
+
 // isGroupAddress returns true if the address is a @group address.
 // Group addresses start with @ and resolve to multiple recipients.
 func isGroupAddress(address string) bool {
@@ -1130,7 +1137,7 @@ func (r *Router) sendToSingle(msg *Message) error {
 	// Flags go first, then -- to end flag parsing, then the positional subject.
 	// This prevents subjects like "--help" from being parsed as flags (see web/api.go).
 	// Let bd auto-generate the ID with the correct database prefix.
-	args := []string{"create",
+	args := []string{"create", "--json",
 		"--assignee", toIdentity,
 		"-d", msg.Body,
 	}
@@ -1167,7 +1174,7 @@ func (r *Router) sendToSingle(msg *Message) error {
 	}
 	ctx, cancel := bdWriteCtx()
 	defer cancel()
-	_, err := runBdCommand(ctx, args, filepath.Dir(beadsDir), beadsDir)
+	out, err := runBdCommand(ctx, args, filepath.Dir(beadsDir), beadsDir)
 	telemetry.RecordMailMessage(context.Background(), "send", telemetry.MailMessageInfo{
 		ID:       msg.ID,
 		From:     msg.From,
@@ -1182,6 +1189,12 @@ func (r *Router) sendToSingle(msg *Message) error {
 		return fmt.Errorf("sending message: %w", err)
 	}
 
+	// Replace the tracking ID with the bead ID so callers can follow the
+	// delivered message (e.g. AwaitDelivery).
+	if beadID := parseCreatedBeadID(out); beadID != "" {
+		msg.ID = beadID
+	}
+
 	// Notify recipient if they have an active session (best-effort notification).
 	// Skip when the caller explicitly suppressed notification (--no-notify)
 	// or for self-mail (handoffs to future-self don't need present-self notified).
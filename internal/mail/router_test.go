@@ -450,6 +450,9 @@ exit 1
 	if err := r.Send(msg); err != nil {
 		t.Fatalf("send from crew workspace should succeed without prefix mismatch: %v", err)
 	}
+	if msg.ID != "hq-testmail-1" {
+		t.Errorf("msg.ID = %q, want bead ID from bd create", msg.ID)
+	}
 }
 
 func TestNewRouterWithTownRoot(t *testing.T) {
@@ -102,6 +102,11 @@ type Message struct {
 	// Pinned marks the message as pinned (won't be auto-archived).
 	Pinned bool `json:"pinned,omitempty"`
 
+	// Archived marks a message that has been moved to the archive (see
+	// ArchiveReadMessages). Archived messages are removed by
+	// PurgeArchivedMessages.
+	Archived bool `json:"archived,omitempty"`
+
 	// Wisp marks this as a transient message (stored in same DB but not synced to git).
 	// Wisp messages auto-cleanup on patrol squash.
 	Wisp bool `json:"wisp,omitempty"`
@@ -172,6 +177,36 @@ func NewReplyMessage(from, to, subject, body string, original *Message) *Message
 	}
 }
 
+// NewReply creates a reply from from to the sender of original. The reply
+// joins original's thread, or starts one keyed by original's ID when
+// original has no thread, and its subject gains a "Re: " prefix.
+func NewReply(original *Message, from, body string) *Message {
+	subject := original.Subject
+	if !strings.HasPrefix(subject, "Re: ") {
+		subject = "Re: " + subject
+	}
+	reply := NewReplyMessage(from, original.From, subject, body, original)
+	if reply.ThreadID == "" {
+		reply.ThreadID = original.ID
+	}
+	return reply
+}
+
+// GroupByThread groups messages by ThreadID. A message without a thread
+// is its own thread, keyed by its ID. Within a group, messages keep their
+// input order.
+func GroupByThread(messages []*Message) map[string][]*Message {
+	threads := make(map[string][]*Message)
+	for _, msg := range messages {
+		key := msg.ThreadID
+		if key == "" {
+			key = msg.ID
+		}
+		threads[key] = append(threads[key], msg)
+	}
+	return threads
+}
+
 // NewQueueMessage creates a message destined for a queue.
 // Queue messages have no direct recipient - they are claimed by eligible agents.
 func NewQueueMessage(from, queue, subject, body string) *Message {
@@ -283,6 +318,12 @@ func GenerateID() string {
 	return "msg-" + hex.EncodeToString(b)
 }
 
+// IsTrackingID reports whether id is an in-memory tracking ID from GenerateID
+// rather than the ID of a message bead.
+func IsTrackingID(id string) bool {
+	return strings.HasPrefix(id, "msg-")
+}
+
 // generateThreadID creates a random thread ID.
 // Falls back to time-based ID if crypto/rand fails (extremely rare).
 func generateThreadID() string {
@@ -438,6 +479,59 @@ func (bm *BeadsMessage) ToMessage() *Message {
 	}
 }
 
+// ToBeadsMessage converts a GGT Message to its beads representation, the
+// inverse of ToMessage. Addresses become beads identities and metadata is
+// carried in labels. Delivery-tracking labels are not produced; those are
+// added by the router at send time.
+func (m *Message) ToBeadsMessage() *BeadsMessage {
+	labels := []string{"gt:message"}
+	if m.From != "" {
+		labels = append(labels, "from:"+AddressToIdentity(m.From))
+	}
+	if m.Type != "" {
+		labels = append(labels, "msg-type:"+string(m.Type))
+	}
+	if m.ThreadID != "" {
+		labels = append(labels, "thread:"+m.ThreadID)
+	}
+	if m.ReplyTo != "" {
+		labels = append(labels, "reply-to:"+m.ReplyTo)
+	}
+	for _, cc := range m.CC {
+		labels = append(labels, "cc:"+AddressToIdentity(cc))
+	}
+	if m.Queue != "" {
+		labels = append(labels, "queue:"+m.Queue)
+	}
+	if m.Channel != "" {
+		labels = append(labels, "channel:"+m.Channel)
+	}
+	if m.ClaimedBy != "" {
+		labels = append(labels, "claimed-by:"+m.ClaimedBy)
+	}
+	if m.ClaimedAt != nil {
+		labels = append(labels, "claimed-at:"+m.ClaimedAt.Format(time.RFC3339))
+	}
+
+	status := "open"
+	if m.Read {
+		status = "closed"
+	}
+
+	return &BeadsMessage{
+		ID:          m.ID,
+		Title:       m.Subject,
+		Description: m.Body,
+		Assignee:    AddressToIdentity(m.To),
+		Priority:    PriorityToBeads(m.Priority),
+		Status:      status,
+		CreatedAt:   m.Timestamp,
+		Labels:      labels,
+		Pinned:      m.Pinned,
+		Wisp:        m.Wisp,
+	}
+}
+
 // GetQueue returns the queue name for queue messages.
 func (bm *BeadsMessage) GetQueue() string {
 	return bm.queue
@@ -619,6 +713,75 @@ func AddressToIdentity(address string) string {
 //   - "gastown/crew/max" → "gastown/max" (normalized)
 //   - "gastown/Toast" → "gastown/Toast" (already canonical)
 //   - "gastown/refinery" → "gastown/refinery"
+//   - "gastown-Toast" → "gastown/Toast" (dash form)
+//   - "gt-crew-bear" → "gt/crew-bear" (split on the first dash only)
+//
+// Dash-form identities are split on the first dash because rig names never
+// contain one, while agent names may. Identities that already contain a
+// slash are never split.
 func identityToAddress(identity string) string {
+	if !strings.Contains(identity, "/") {
+		if rig, name, ok := strings.Cut(identity, "-"); ok && rig != "" && name != "" {
+			identity = rig + "/" + name
+		}
+	}
 	return normalizeAddress(identity)
 }
+
+// ValidateAddress checks that addr is a well-formed mail address:
+// "overseer", "mayor", "deacon", "<rig>/" (rig broadcast), "<rig>/<name>" or
+// "<rig>/crew|polecats/<name>", or a list:, queue:, announce:, channel: or
+// @group address with a non-empty name.
+func ValidateAddress(addr string) error {
+	if addr == "" {
+		return fmt.Errorf("empty address")
+	}
+	if strings.ContainsAny(addr, " \t\n") {
+		return fmt.Errorf("address %q contains whitespace", addr)
+	}
+	switch addr {
+	case "overseer", "mayor", "deacon": // normalizeAddress accepts the bare town roles
+		return nil
+	}
+	for _, prefix := range []string{"list:", "queue:", "announce:", "channel:", "@"} {
+		if strings.HasPrefix(addr, prefix) {
+			if addr == prefix {
+				return fmt.Errorf("address %q has no name after %q", addr, prefix)
+			}
+			return nil
+		}
+	}
+
+	parts := strings.Split(addr, "/")
+	switch {
+	case len(parts) == 1:
+		return fmt.Errorf("address %q must have the form <rig>/[<name>]", addr)
+	case parts[0] == "":
+		return fmt.Errorf("address %q has an empty rig", addr)
+	case len(parts) == 2:
+		return nil // "<rig>/" or "<rig>/<name>"
+	case len(parts) == 3 && (parts[1] == "crew" || parts[1] == "polecats") && parts[2] != "":
+		return nil
+	default:
+		return fmt.Errorf("address %q must have the form <rig>/[<name>]", addr)
+	}
+}
+
+// ValidateIdentity checks that id is a well-formed beads identity: a slash
+// form accepted by ValidateAddress, a dash form "<rig>-<name>", or a bare
+// name such as "overseer", "mayor" or a rig name (rig broadcast).
+func ValidateIdentity(id string) error {
+	if id == "" {
+		return fmt.Errorf("empty identity")
+	}
+	if strings.ContainsAny(id, " \t\n") {
+		return fmt.Errorf("identity %q contains whitespace", id)
+	}
+	if strings.Contains(id, "/") {
+		return ValidateAddress(id)
+	}
+	if rig, name, ok := strings.Cut(id, "-"); ok && (rig == "" || name == "") {
+		return fmt.Errorf("identity %q must have the form <rig>-<name>", id)
+	}
+	return nil
+}
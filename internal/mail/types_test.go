@@ -3,6 +3,7 @@ package mail
 import (
 	"encoding/json"
 	"testing"
+	"testing/quick"
 	"time"
 )
 
@@ -45,6 +46,82 @@ func TestAddressToIdentity(t *testing.T) {
 	}
 }
 
+func TestAddressIdentityRoundTrip(t *testing.T) {
+	tests := []struct {
+		identity string
+		address  string
+	}{
+		{"mayor", "mayor/"},
+		{"overseer", "overseer"},
+		{"gastown-Toast", "gastown/Toast"},
+		{"gt-crew-bear", "gt/crew-bear"},
+		{"bd-refinery", "bd/refinery"},
+		{"gastown/crew/max", "gastown/max"},
+		{"gastown/witness", "gastown/witness"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.identity, func(t *testing.T) {
+			if err := ValidateIdentity(tt.identity); err != nil {
+				t.Errorf("ValidateIdentity(%q) = %v", tt.identity, err)
+			}
+			addr := identityToAddress(tt.identity)
+			if addr != tt.address {
+				t.Fatalf("identityToAddress(%q) = %q, want %q", tt.identity, addr, tt.address)
+			}
+			if err := ValidateAddress(addr); err != nil {
+				t.Errorf("ValidateAddress(%q) = %v", addr, err)
+			}
+			back := AddressToIdentity(addr)
+			if err := ValidateIdentity(back); err != nil {
+				t.Errorf("ValidateIdentity(%q) = %v", back, err)
+			}
+			if got := identityToAddress(back); got != addr {
+				t.Errorf("round trip %q -> %q -> %q, want %q", addr, back, got, addr)
+			}
+		})
+	}
+}
+
+func TestValidateAddress(t *testing.T) {
+	valid := []string{
+		"overseer", "mayor", "mayor/", "deacon/", "gastown/", "gastown/Toast",
+		"gastown/crew/max", "gastown/polecats/Toast", "gt/crew-bear",
+		"list:oncall", "queue:work", "announce:alerts", "channel:ops", "@town",
+	}
+	for _, addr := range valid {
+		if err := ValidateAddress(addr); err != nil {
+			t.Errorf("ValidateAddress(%q) = %v, want nil", addr, err)
+		}
+	}
+
+	invalid := []string{
+		"", "gastown", "/Toast", "gastown/crew/", "gastown/foo/bar",
+		"a/b/c/d", "gastown/To ast", "list:", "@",
+	}
+	for _, addr := range invalid {
+		if err := ValidateAddress(addr); err == nil {
+			t.Errorf("ValidateAddress(%q) = nil, want error", addr)
+		}
+	}
+}
+
+func TestValidateIdentity(t *testing.T) {
+	valid := []string{"overseer", "mayor", "mayor/", "gastown", "gastown-Toast", "gt-crew-bear", "gastown/Toast"}
+	for _, id := range valid {
+		if err := ValidateIdentity(id); err != nil {
+			t.Errorf("ValidateIdentity(%q) = %v, want nil", id, err)
+		}
+	}
+
+	invalid := []string{"", "-Toast", "gastown-", "gastown Toast", "/Toast"}
+	for _, id := range invalid {
+		if err := ValidateIdentity(id); err == nil {
+			t.Errorf("ValidateIdentity(%q) = nil, want error", id)
+		}
+	}
+}
+
 func TestIdentityToAddress(t *testing.T) {
 	tests := []struct {
 		identity string
@@ -65,6 +142,12 @@ func TestIdentityToAddress(t *testing.T) {
 
 		// Rig name only (no transformation)
 		{"gastown", "gastown"},
+
+		// Dash form: split on the first dash only
+		{"gastown-Toast", "gastown/Toast"},
+		{"gt-crew-bear", "gt/crew-bear"},
+		{"bd-refinery", "bd/refinery"},
+		{"gastown/crew-bear", "gastown/crew-bear"}, // slash form is never split
 	}
 
 	for _, tt := range tests {
@@ -229,6 +312,67 @@ func TestNewReplyMessage(t *testing.T) {
 	}
 }
 
+func TestNewReplyChainSharesThread(t *testing.T) {
+	original := &Message{
+		ID:      "orig-001",
+		From:    "gastown/Toast",
+		To:      "mayor/",
+		Subject: "Status",
+	}
+
+	first := NewReply(original, "mayor/", "ack")
+	if first.ThreadID != "orig-001" {
+		t.Errorf("first.ThreadID = %q, want original ID when original has no thread", first.ThreadID)
+	}
+	if first.ReplyTo != "orig-001" {
+		t.Errorf("first.ReplyTo = %q, want 'orig-001'", first.ReplyTo)
+	}
+	if first.From != "mayor/" || first.To != "gastown/Toast" {
+		t.Errorf("first From/To = %q/%q, want mayor//gastown/Toast", first.From, first.To)
+	}
+	if first.Subject != "Re: Status" {
+		t.Errorf("first.Subject = %q, want 'Re: Status'", first.Subject)
+	}
+
+	second := NewReply(first, "gastown/Toast", "thanks")
+	third := NewReply(second, "mayor/", "np")
+	for _, m := range []*Message{second, third} {
+		if m.ThreadID != "orig-001" {
+			t.Errorf("%s ThreadID = %q, want 'orig-001'", m.Body, m.ThreadID)
+		}
+		if m.Subject != "Re: Status" {
+			t.Errorf("%s Subject = %q, want 'Re: Status'", m.Body, m.Subject)
+		}
+	}
+	if second.ReplyTo != first.ID || third.ReplyTo != second.ID {
+		t.Errorf("ReplyTo chain = %q, %q; want %q, %q", second.ReplyTo, third.ReplyTo, first.ID, second.ID)
+	}
+	if second.To != "mayor/" {
+		t.Errorf("second.To = %q, want 'mayor/'", second.To)
+	}
+}
+
+func TestGroupByThread(t *testing.T) {
+	a1 := &Message{ID: "a1", ThreadID: "thread-a"}
+	a2 := &Message{ID: "a2", ThreadID: "thread-a"}
+	b1 := &Message{ID: "b1", ThreadID: "thread-b"}
+	loose := &Message{ID: "loose"}
+
+	groups := GroupByThread([]*Message{a1, b1, a2, loose})
+	if len(groups) != 3 {
+		t.Fatalf("got %d groups, want 3: %v", len(groups), groups)
+	}
+	if got := groups["thread-a"]; len(got) != 2 || got[0] != a1 || got[1] != a2 {
+		t.Errorf("thread-a = %v, want [a1 a2]", got)
+	}
+	if got := groups["thread-b"]; len(got) != 1 || got[0] != b1 {
+		t.Errorf("thread-b = %v, want [b1]", got)
+	}
+	if got := groups["loose"]; len(got) != 1 || got[0] != loose {
+		t.Errorf("unthreaded message grouped under %v, want its own ID", got)
+	}
+}
+
 func TestBeadsMessageToMessage(t *testing.T) {
 	now := time.Now()
 	bm := BeadsMessage{
@@ -349,6 +493,37 @@ func TestBeadsMessageToMessagePriorities(t *testing.T) {
 	}
 }
 
+func TestToBeadsMessageRoundTrip(t *testing.T) {
+	priorities := []Priority{PriorityUrgent, PriorityHigh, PriorityNormal, PriorityLow}
+	addresses := []string{"mayor/", "deacon", "overseer", "gastown/Toast", "gastown/polecats/Nux", "gastown/crew/max", "gastown/refinery"}
+
+	roundTrip := func(subject, body string, p, from, to uint8, read bool) bool {
+		msg := &Message{
+			ID:       "hq-rt",
+			From:     addresses[int(from)%len(addresses)],
+			To:       addresses[int(to)%len(addresses)],
+			Subject:  subject,
+			Body:     body,
+			Priority: priorities[int(p)%len(priorities)],
+			Type:     TypeTask,
+			ThreadID: "thread-rt",
+			Read:     read,
+		}
+		got := msg.ToBeadsMessage().ToMessage()
+		return got.Subject == msg.Subject &&
+			got.Body == msg.Body &&
+			got.Priority == msg.Priority &&
+			got.Read == msg.Read &&
+			got.ThreadID == msg.ThreadID &&
+			got.Type == msg.Type &&
+			got.From == identityToAddress(AddressToIdentity(msg.From)) &&
+			got.To == identityToAddress(AddressToIdentity(msg.To))
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestBeadsMessageToMessageTypes(t *testing.T) {
 	tests := []struct {
 		msgType  string
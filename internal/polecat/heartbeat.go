@@ -2,14 +2,20 @@ package polecat
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
 )
 
 // SessionHeartbeatStaleThreshold is the age at which a polecat session heartbeat
 // is considered stale, indicating the agent process is likely dead.
-// Configurable via operational.polecat.heartbeat_stale_threshold in settings/config.json.
+// Configurable via operational.polecat.heartbeat_stale_threshold in settings/config.json
+// or per town via .runtime/heartbeat-config.json (see LoadHeartbeatConfig).
 const SessionHeartbeatStaleThreshold = 3 * time.Minute
 
 // HeartbeatState represents the agent-reported state in a heartbeat v2 (gt-3vr5).
@@ -112,8 +118,48 @@ func ReadSessionHeartbeat(townRoot, sessionName string) *SessionHeartbeat {
 	return &hb
 }
 
+// HeartbeatConfig holds the per-town session heartbeat settings.
+type HeartbeatConfig struct {
+	// StaleThreshold is the age at which a session heartbeat is stale.
+	StaleThreshold time.Duration
+}
+
+// heartbeatConfigFile is the on-disk form of HeartbeatConfig, with the
+// threshold as a duration string (e.g. "90s").
+type heartbeatConfigFile struct {
+	StaleThreshold string `json:"stale_threshold,omitempty"`
+}
+
+// heartbeatConfigPath returns the path to the town's heartbeat config file.
+func heartbeatConfigPath(townRoot string) string {
+	return filepath.Join(townRoot, ".runtime", "heartbeat-config.json")
+}
+
+// LoadHeartbeatConfig reads <townRoot>/.runtime/heartbeat-config.json. A
+// missing file, unreadable file or invalid threshold falls back to
+// operational.polecat.heartbeat_stale_threshold (default
+// SessionHeartbeatStaleThreshold).
+func LoadHeartbeatConfig(townRoot string) HeartbeatConfig {
+	cfg := HeartbeatConfig{
+		StaleThreshold: config.LoadOperationalConfig(townRoot).GetPolecatConfig().HeartbeatStaleThresholdD(),
+	}
+
+	data, err := os.ReadFile(heartbeatConfigPath(townRoot))
+	if err != nil {
+		return cfg
+	}
+	var file heartbeatConfigFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return cfg
+	}
+	if d := config.ParseDurationOrDefault(file.StaleThreshold, 0); d > 0 {
+		cfg.StaleThreshold = d
+	}
+	return cfg
+}
+
 // IsSessionHeartbeatStale returns true if the session's heartbeat is older than
-// the stale threshold, or if no heartbeat file exists.
+// the stale threshold from LoadHeartbeatConfig, or if no heartbeat file exists.
 //
 // When no heartbeat file exists, this returns false to avoid false positives
 // during the rollout period where sessions may not yet be touching heartbeats.
@@ -123,7 +169,15 @@ func IsSessionHeartbeatStale(townRoot, sessionName string) (stale bool, exists b
 	if hb == nil {
 		return false, false
 	}
-	return time.Since(hb.Timestamp) >= SessionHeartbeatStaleThreshold, true
+	return time.Since(hb.Timestamp) >= LoadHeartbeatConfig(townRoot).StaleThreshold, true
+}
+
+// IsHeartbeatStaleWithConfig reports whether the session's heartbeat is at
+// least cfg.StaleThreshold old. Like IsSessionHeartbeatStale, a missing
+// heartbeat is not stale.
+func IsHeartbeatStaleWithConfig(townRoot, sessionID string, cfg HeartbeatConfig) bool {
+	hb := ReadSessionHeartbeat(townRoot, sessionID)
+	return hb != nil && time.Since(hb.Timestamp) >= cfg.StaleThreshold
 }
 
 // RemoveSessionHeartbeat removes the heartbeat file for a session.
@@ -131,3 +185,97 @@ func IsSessionHeartbeatStale(townRoot, sessionName string) (stale bool, exists b
 func RemoveSessionHeartbeat(townRoot, sessionName string) {
 	_ = os.Remove(heartbeatFile(townRoot, sessionName))
 }
+
+// HeartbeatEntry describes one session heartbeat file. Age is measured from
+// the heartbeat's JSON timestamp, as in IsSessionHeartbeatStale.
+type HeartbeatEntry struct {
+	SessionID string        `json:"session_id"`
+	Age       time.Duration `json:"age"`
+	ModTime   time.Time     `json:"mod_time"`
+}
+
+// ListHeartbeats returns every session heartbeat, freshest first, with all
+// ages measured from one clock reading. Split it by Age to get active and
+// stale entries from a single read of the directory.
+func ListHeartbeats(townRoot string) ([]HeartbeatEntry, error) {
+	return filterHeartbeats(townRoot, func(time.Duration) bool { return true })
+}
+
+// ListActiveHeartbeats returns sessions whose heartbeat is younger than
+// threshold, freshest first. A missing heartbeats directory yields no entries.
+func ListActiveHeartbeats(townRoot string, threshold time.Duration) ([]HeartbeatEntry, error) {
+	return filterHeartbeats(townRoot, func(age time.Duration) bool { return age < threshold })
+}
+
+// ListStaleHeartbeats returns sessions whose heartbeat is at least threshold
+// old, freshest first. It is the complement of ListActiveHeartbeats.
+func ListStaleHeartbeats(townRoot string, threshold time.Duration) ([]HeartbeatEntry, error) {
+	return filterHeartbeats(townRoot, func(age time.Duration) bool { return age >= threshold })
+}
+
+// filterHeartbeats lists heartbeat files by the age of their JSON timestamp,
+// keeping those for which keep returns true, sorted by age ascending. A file
+// whose content can't be parsed is aged by its mtime so it can still be
+// listed and cleaned up.
+func filterHeartbeats(townRoot string, keep func(age time.Duration) bool) ([]HeartbeatEntry, error) {
+	entries, err := os.ReadDir(heartbeatsDir(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	var result []HeartbeatEntry
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue // removed between ReadDir and Info
+		}
+		sessionID := strings.TrimSuffix(name, ".json")
+		beat := info.ModTime()
+		if hb := ReadSessionHeartbeat(townRoot, sessionID); hb != nil && !hb.Timestamp.IsZero() {
+			beat = hb.Timestamp
+		}
+		age := now.Sub(beat)
+		if !keep(age) {
+			continue
+		}
+		result = append(result, HeartbeatEntry{
+			SessionID: sessionID,
+			Age:       age,
+			ModTime:   info.ModTime(),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Age < result[j].Age })
+	return result, nil
+}
+
+// CleanupStaleHeartbeats removes every heartbeat file at least threshold
+// old and returns the paths it removed. With dryRun, it returns the paths
+// that would be removed without deleting anything.
+func CleanupStaleHeartbeats(townRoot string, threshold time.Duration, dryRun bool) (removed []string, err error) {
+	stale, err := ListStaleHeartbeats(townRoot, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, entry := range stale {
+		path := heartbeatFile(townRoot, entry.SessionID)
+		if !dryRun {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		removed = append(removed, path)
+	}
+	return removed, errors.Join(errs...)
+}
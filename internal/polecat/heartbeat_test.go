@@ -144,6 +144,60 @@ func TestIsSessionHeartbeatStale_Old(t *testing.T) {
 	}
 }
 
+func TestLoadHeartbeatConfig(t *testing.T) {
+	townRoot := t.TempDir()
+
+	if got := LoadHeartbeatConfig(townRoot).StaleThreshold; got != SessionHeartbeatStaleThreshold {
+		t.Errorf("default StaleThreshold = %v, want %v", got, SessionHeartbeatStaleThreshold)
+	}
+
+	if err := os.MkdirAll(filepath.Join(townRoot, ".runtime"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	cfgPath := filepath.Join(townRoot, ".runtime", "heartbeat-config.json")
+	if err := os.WriteFile(cfgPath, []byte(`{"stale_threshold":"1m"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg := LoadHeartbeatConfig(townRoot)
+	if cfg.StaleThreshold != time.Minute {
+		t.Fatalf("StaleThreshold = %v, want 1m", cfg.StaleThreshold)
+	}
+
+	dir := filepath.Join(townRoot, ".runtime", "heartbeats")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, age := range map[string]time.Duration{"gt-old": 2 * time.Minute, "gt-recent": 30 * time.Second} {
+		ts := time.Now().Add(-age).UTC()
+		data := []byte(`{"timestamp":"` + ts.Format(time.RFC3339Nano) + `"}`)
+		if err := os.WriteFile(filepath.Join(dir, name+".json"), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !IsHeartbeatStaleWithConfig(townRoot, "gt-old", cfg) {
+		t.Error("2-minute-old heartbeat should be stale with a 1m threshold")
+	}
+	if IsHeartbeatStaleWithConfig(townRoot, "gt-recent", cfg) {
+		t.Error("30-second-old heartbeat should not be stale with a 1m threshold")
+	}
+	if IsHeartbeatStaleWithConfig(townRoot, "gt-missing", cfg) {
+		t.Error("missing heartbeat should not be stale")
+	}
+	// IsSessionHeartbeatStale picks up the file too.
+	if stale, _ := IsSessionHeartbeatStale(townRoot, "gt-old"); !stale {
+		t.Error("IsSessionHeartbeatStale ignored heartbeat-config.json")
+	}
+
+	// An invalid threshold falls back to the default.
+	if err := os.WriteFile(cfgPath, []byte(`{"stale_threshold":"soon"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := LoadHeartbeatConfig(townRoot).StaleThreshold; got != SessionHeartbeatStaleThreshold {
+		t.Errorf("invalid StaleThreshold = %v, want default %v", got, SessionHeartbeatStaleThreshold)
+	}
+}
+
 func TestRemoveSessionHeartbeat(t *testing.T) {
 	townRoot := t.TempDir()
 
@@ -307,3 +361,141 @@ func TestReadSessionHeartbeat_V2AllStates(t *testing.T) {
 		})
 	}
 }
+
+// writeHeartbeatAt writes a session heartbeat whose JSON timestamp is ts.
+// The file's mtime stays at the time of writing.
+func writeHeartbeatAt(t *testing.T, townRoot, session string, ts time.Time) {
+	t.Helper()
+	if err := os.MkdirAll(heartbeatsDir(townRoot), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := []byte(`{"timestamp":"` + ts.UTC().Format(time.RFC3339Nano) + `","state":"working"}`)
+	if err := os.WriteFile(heartbeatFile(townRoot, session), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestListStaleHeartbeats_UsesTimestampNotMtime(t *testing.T) {
+	townRoot := t.TempDir()
+
+	// Freshly written file, old timestamp: stale, matching IsSessionHeartbeatStale.
+	writeHeartbeatAt(t, townRoot, "gt-old-beat", time.Now().Add(-10*time.Minute))
+	// Unparseable file with an old mtime: aged by mtime.
+	if err := os.WriteFile(heartbeatFile(townRoot, "gt-corrupt"), []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(heartbeatFile(townRoot, "gt-corrupt"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	stale, err := ListStaleHeartbeats(townRoot, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("ListStaleHeartbeats: %v", err)
+	}
+	if len(stale) != 2 || stale[0].SessionID != "gt-old-beat" || stale[1].SessionID != "gt-corrupt" {
+		t.Errorf("stale = %+v, want [gt-old-beat gt-corrupt]", stale)
+	}
+	if isStale, _ := IsSessionHeartbeatStale(townRoot, "gt-old-beat"); !isStale {
+		t.Error("IsSessionHeartbeatStale disagrees with ListStaleHeartbeats")
+	}
+}
+
+func TestListActiveAndStaleHeartbeats(t *testing.T) {
+	townRoot := t.TempDir()
+	now := time.Now()
+
+	ages := map[string]time.Duration{
+		"gt-fresh":  10 * time.Second,
+		"gt-recent": 2 * time.Minute,
+		"gt-old":    10 * time.Minute,
+	}
+	for session, age := range ages {
+		writeHeartbeatAt(t, townRoot, session, now.Add(-age))
+	}
+	// Non-heartbeat files are ignored.
+	if err := os.WriteFile(filepath.Join(heartbeatsDir(townRoot), "notes.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	active, err := ListActiveHeartbeats(townRoot, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("ListActiveHeartbeats: %v", err)
+	}
+	if len(active) != 2 || active[0].SessionID != "gt-fresh" || active[1].SessionID != "gt-recent" {
+		t.Errorf("active = %+v, want [gt-fresh gt-recent]", active)
+	}
+
+	stale, err := ListStaleHeartbeats(townRoot, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("ListStaleHeartbeats: %v", err)
+	}
+	if len(stale) != 1 || stale[0].SessionID != "gt-old" {
+		t.Errorf("stale = %+v, want [gt-old]", stale)
+	}
+	if stale[0].Age < 10*time.Minute {
+		t.Errorf("stale age = %v, want >= 10m", stale[0].Age)
+	}
+
+	all, err := ListHeartbeats(townRoot)
+	if err != nil {
+		t.Fatalf("ListHeartbeats: %v", err)
+	}
+	if len(all) != 3 || all[0].SessionID != "gt-fresh" || all[2].SessionID != "gt-old" {
+		t.Errorf("all = %+v, want [gt-fresh gt-recent gt-old]", all)
+	}
+}
+
+func TestListActiveHeartbeats_NoDir(t *testing.T) {
+	active, err := ListActiveHeartbeats(t.TempDir(), time.Minute)
+	if err != nil {
+		t.Fatalf("ListActiveHeartbeats: %v", err)
+	}
+	if active != nil {
+		t.Errorf("active = %+v, want nil", active)
+	}
+}
+
+func TestCleanupStaleHeartbeats(t *testing.T) {
+	townRoot := t.TempDir()
+	old := time.Now().Add(-time.Hour)
+
+	TouchSessionHeartbeat(townRoot, "gt-live")
+	for i, session := range []string{"gt-dead-a", "gt-dead-b"} {
+		writeHeartbeatAt(t, townRoot, session, old.Add(-time.Duration(i)*time.Minute))
+	}
+
+	// Dry run lists stale files without removing them.
+	removed, err := CleanupStaleHeartbeats(townRoot, 5*time.Minute, true)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if len(removed) != 2 {
+		t.Fatalf("dry run removed = %v, want 2 paths", removed)
+	}
+	for _, path := range removed {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("dry run deleted %s: %v", path, err)
+		}
+	}
+
+	// Live mode removes them and reports the same paths.
+	live, err := CleanupStaleHeartbeats(townRoot, 5*time.Minute, false)
+	if err != nil {
+		t.Fatalf("cleanup: %v", err)
+	}
+	if len(live) != 2 {
+		t.Fatalf("removed = %v, want 2 paths", live)
+	}
+	for i, path := range live {
+		if path != removed[i] {
+			t.Errorf("removed[%d] = %s, want %s", i, path, removed[i])
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("%s still exists after cleanup", path)
+		}
+	}
+	if ReadSessionHeartbeat(townRoot, "gt-live") == nil {
+		t.Error("fresh heartbeat was removed")
+	}
+}
@@ -265,7 +265,8 @@ func TestPolecatStartInjectsFallbackEnvVars(t *testing.T) {
 		"GT_RIG",          // Rig name (was already there pre-PR)
 		"GT_POLECAT",      // Polecat name (was already there pre-PR)
 		"GT_ROLE",         // Role address (was already there pre-PR)
-		"GT_TOWN_ROOT",    // Town root for FindFromCwdWithFallback after worktree nuke
+		"GT_ROOT",         // Town root for FindFromCwdWithFallback after worktree nuke
+		"GT_TOWN_ROOT",    // Legacy alias of GT_ROOT (deprecation window)
 	}
 
 	// Verify the env var map includes all required keys
@@ -274,7 +275,9 @@ func TestPolecatStartInjectsFallbackEnvVars(t *testing.T) {
 		"GT_POLECAT":      polecatName,
 		"GT_ROLE":         rigName + "/polecats/" + polecatName,
 		"GT_POLECAT_PATH": workDir,
-		"GT_TOWN_ROOT":    townRoot,
+	}
+	for k, v := range config.RootEnv(townRoot) {
+		envVars[k] = v
 	}
 
 	// GT_BRANCH is conditionally added (only if CurrentBranch succeeds)
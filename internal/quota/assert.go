@@ -0,0 +1,118 @@
+package quota
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// AssertOpts holds the thresholds for AssertQuota.
+type AssertOpts struct {
+	// AllowNearLimit passes sessions that show near-limit warnings.
+	AllowNearLimit bool
+}
+
+// AssertQuota decides whether the session or account described by result is
+// safe to load with new work. It is a pure function of its inputs so that
+// dispatch hooks get the same answer the tests pin down.
+func AssertQuota(result ScanResult, opts AssertOpts) (ok bool, reason string) {
+	switch {
+	case result.RateLimited:
+		if result.ResetsAt != "" {
+			return false, fmt.Sprintf("rate-limited (resets %s)", result.ResetsAt)
+		}
+		return false, "rate-limited"
+	case result.IdentityMismatch != nil:
+		return false, "identity mismatch: " + result.IdentityMismatch.String()
+	case result.NearLimit && !opts.AllowNearLimit:
+		return false, "near rate limit"
+	}
+	return true, "ok"
+}
+
+// AssertQuery selects what `gt quota assert` checks.
+type AssertQuery struct {
+	Session string        // tmux session to check
+	Account string        // account handle to check (used when Session is empty)
+	MaxAge  time.Duration // how old a cached scan may be
+	Now     time.Time
+}
+
+// Sources reported by ResolveAssertResult.
+const (
+	AssertSourceCache = "cache" // fresh cached scan
+	AssertSourceLive  = "live"  // single-session live scan
+	AssertSourceState = "state" // persisted quota state only
+)
+
+// ResolveAssertResult builds the ScanResult that AssertQuota evaluates.
+//
+// Session queries use the cached scan when it is fresh and contains the
+// session; otherwise only that session is scanned live. newScanner is
+// called lazily so cache hits never touch tmux. Account queries merge the
+// account's sessions from a fresh cache. In both cases an account that
+// persisted quota state marks as limited (and not yet reset) is reported as
+// rate-limited.
+func ResolveAssertResult(mgr *Manager, newScanner func() (*Scanner, error), q AssertQuery) (ScanResult, string, error) {
+	if (q.Session == "") == (q.Account == "") {
+		return ScanResult{}, "", errors.New("exactly one of session or account is required")
+	}
+	if q.MaxAge <= 0 {
+		q.MaxAge = DefaultScanCacheMaxAge
+	}
+
+	cache, err := mgr.LoadScanCache()
+	if err != nil || !cache.Fresh(q.Now, q.MaxAge) {
+		cache = nil // unreadable or stale cache: fall through to live/state
+	}
+
+	var result ScanResult
+	source := AssertSourceCache
+	if q.Session != "" {
+		r, ok := cache.Lookup(q.Session)
+		if !ok {
+			scanner, err := newScanner()
+			if err != nil {
+				return ScanResult{}, "", err
+			}
+			if r, err = scanner.ScanSession(q.Session); err != nil {
+				return ScanResult{}, "", err
+			}
+			source = AssertSourceLive
+		}
+		result = r
+	} else {
+		result = ScanResult{AccountHandle: q.Account}
+		if cache == nil {
+			source = AssertSourceState
+		} else {
+			for _, r := range cache.Results {
+				if r.AccountHandle != q.Account {
+					continue
+				}
+				if r.RateLimited && !result.RateLimited {
+					result.RateLimited, result.ResetsAt, result.MatchedLine = true, r.ResetsAt, r.MatchedLine
+				}
+				result.NearLimit = result.NearLimit || r.NearLimit
+				if r.IdentityMismatch != nil {
+					result.IdentityMismatch = r.IdentityMismatch
+				}
+			}
+		}
+	}
+
+	if result.AccountHandle != "" && !result.RateLimited {
+		state, err := mgr.Load()
+		if err != nil {
+			return ScanResult{}, "", err
+		}
+		clearExpiredAt(mgr, state, q.Now)
+		if acct := state.Accounts[result.AccountHandle]; acct.Status == config.QuotaStatusLimited {
+			result.RateLimited = true
+			result.ResetsAt = acct.ResetsAt
+		}
+	}
+	return result, source, nil
+}
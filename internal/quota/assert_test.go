@@ -0,0 +1,149 @@
+package quota
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestAssertQuota(t *testing.T) {
+	mismatch := &IdentityMismatch{ConfiguredOrgID: "org-work", ActualOrgID: "org-personal"}
+	tests := []struct {
+		name       string
+		result     ScanResult
+		opts       AssertOpts
+		wantOK     bool
+		wantReason string
+	}{
+		{"clean", ScanResult{}, AssertOpts{}, true, "ok"},
+		{"hard limit", ScanResult{RateLimited: true}, AssertOpts{}, false, "rate-limited"},
+		{"hard limit with reset", ScanResult{RateLimited: true, ResetsAt: "7pm"}, AssertOpts{}, false, "rate-limited (resets 7pm)"},
+		{"hard limit not overridden by allow-near-limit", ScanResult{RateLimited: true}, AssertOpts{AllowNearLimit: true}, false, "rate-limited"},
+		{"hard limit wins over near limit", ScanResult{RateLimited: true, NearLimit: true}, AssertOpts{}, false, "rate-limited"},
+		{"near limit", ScanResult{NearLimit: true}, AssertOpts{}, false, "near rate limit"},
+		{"near limit allowed", ScanResult{NearLimit: true}, AssertOpts{AllowNearLimit: true}, true, "ok"},
+		{"identity mismatch", ScanResult{IdentityMismatch: mismatch}, AssertOpts{}, false, "identity mismatch: "},
+		{"identity mismatch not overridden", ScanResult{IdentityMismatch: mismatch}, AssertOpts{AllowNearLimit: true}, false, "identity mismatch: "},
+		{"hard limit wins over mismatch", ScanResult{RateLimited: true, IdentityMismatch: mismatch}, AssertOpts{}, false, "rate-limited"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, reason := AssertQuota(tt.result, tt.opts)
+			if ok != tt.wantOK || !strings.HasPrefix(reason, tt.wantReason) {
+				t.Errorf("AssertQuota() = (%v, %q), want (%v, %q...)", ok, reason, tt.wantOK, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestResolveAssertResult_CacheHit(t *testing.T) {
+	mgr := NewManager(setupTestTown(t))
+	now := time.Now()
+	if err := mgr.SaveScanCache([]ScanResult{{Session: "gt-crew-bear", AccountHandle: "work", NearLimit: true}}, now.Add(-time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	noScanner := func() (*Scanner, error) {
+		t.Fatal("cache hit must not touch tmux")
+		return nil, nil
+	}
+	r, source, err := ResolveAssertResult(mgr, noScanner, AssertQuery{Session: "gt-crew-bear", Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source != AssertSourceCache || !r.NearLimit {
+		t.Errorf("got %+v from %s, want cached near-limit result", r, source)
+	}
+}
+
+func TestResolveAssertResult_StaleCacheScansOneSession(t *testing.T) {
+	setupTestRegistry(t)
+	mgr := NewManager(setupTestTown(t))
+	now := time.Now()
+	// Stale cache says the session is fine; the live pane says otherwise.
+	if err := mgr.SaveScanCache([]ScanResult{{Session: "gt-crew-bear"}}, now.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	tmux := &mockTmux{
+		sessions: []string{"gt-crew-bear", "gt-witness"},
+		paneContent: map[string]string{
+			"gt-crew-bear": "You've hit your limit · resets 7pm (America/Los_Angeles)",
+		},
+	}
+	scanner, err := NewScanner(tmux, nil, &config.AccountsConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := 0
+	newScanner := func() (*Scanner, error) { calls++; return scanner, nil }
+
+	r, source, err := ResolveAssertResult(mgr, newScanner, AssertQuery{Session: "gt-crew-bear", Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 || source != AssertSourceLive || !r.RateLimited {
+		t.Errorf("got %+v from %s (scanner calls %d), want live rate-limited", r, source, calls)
+	}
+
+	// A session that isn't running is an error, not a pass.
+	if _, _, err := ResolveAssertResult(mgr, newScanner, AssertQuery{Session: "gt-crew-ghost", Now: now}); err == nil {
+		t.Error("expected error for missing session")
+	}
+
+	// Scanner construction failures are surfaced.
+	boom := errors.New("no tmux")
+	if _, _, err := ResolveAssertResult(mgr, func() (*Scanner, error) { return nil, boom }, AssertQuery{Session: "gt-crew-bear", Now: now}); !errors.Is(err, boom) {
+		t.Errorf("err = %v, want %v", err, boom)
+	}
+}
+
+func TestResolveAssertResult_Account(t *testing.T) {
+	mgr := NewManager(setupTestTown(t))
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	state := &config.QuotaState{
+		Version: config.CurrentQuotaVersion,
+		Accounts: map[string]config.AccountQuotaState{
+			"limited": {Status: config.QuotaStatusLimited, ResetsAt: "7pm (UTC)"},
+			"reset":   {Status: config.QuotaStatusLimited, ResetsAt: "9am (UTC)"},
+			"free":    {Status: config.QuotaStatusAvailable},
+		},
+	}
+	if err := mgr.Save(state); err != nil {
+		t.Fatal(err)
+	}
+	if err := mgr.SaveScanCache([]ScanResult{
+		{Session: "gt-a", AccountHandle: "free"},
+		{Session: "gt-b", AccountHandle: "free", NearLimit: true},
+	}, now); err != nil {
+		t.Fatal(err)
+	}
+	noScanner := func() (*Scanner, error) { t.Fatal("account queries never scan live"); return nil, nil }
+
+	tests := []struct {
+		account     string
+		wantLimited bool
+		wantNear    bool
+	}{
+		{"limited", true, false},
+		{"reset", false, false}, // reset time has passed
+		{"free", false, true},   // merged from the cached sessions
+	}
+	for _, tt := range tests {
+		r, _, err := ResolveAssertResult(mgr, noScanner, AssertQuery{Account: tt.account, Now: now})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.RateLimited != tt.wantLimited || r.NearLimit != tt.wantNear {
+			t.Errorf("%s: got limited=%v near=%v, want %v/%v", tt.account, r.RateLimited, r.NearLimit, tt.wantLimited, tt.wantNear)
+		}
+	}
+
+	if _, _, err := ResolveAssertResult(mgr, noScanner, AssertQuery{Now: now}); err == nil {
+		t.Error("expected error when neither session nor account is given")
+	}
+}
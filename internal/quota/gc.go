@@ -0,0 +1,185 @@
+package quota
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// StateFile describes a quota-owned state file for runtime hygiene.
+// Each feature that persists state registers one entry (see RegisterStateFile)
+// next to the code that owns the file, so RuntimeGC never needs to know the
+// file's schema.
+type StateFile struct {
+	// Name is a short identifier used in reports (e.g., "quota-state").
+	Name string
+
+	// Path returns the file location for a town.
+	Path func(townRoot string) string
+
+	// Validate reports whether data can be parsed by the current schema.
+	// Files that fail validation are quarantined.
+	Validate func(data []byte) error
+
+	// Prune removes expired entries as of now. It returns the rewritten
+	// contents and the number of entries removed; when removed is 0 the
+	// file is left untouched. Optional.
+	Prune func(data []byte, now time.Time) (out []byte, removed int, err error)
+
+	// MaxBytes caps the file size after pruning. Files still larger than
+	// this are quarantined so the owner starts fresh. Zero means no cap.
+	MaxBytes int64
+
+	// Lock acquires the owner's lock for the file while GC rewrites it.
+	// Optional.
+	Lock func(townRoot string) (func(), error)
+}
+
+// GCFileReport describes what RuntimeGC did (or would do) to one file.
+type GCFileReport struct {
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	Missing     bool   `json:"missing,omitempty"`
+	Quarantined string `json:"quarantined,omitempty"` // destination of the quarantined file
+	Reason      string `json:"reason,omitempty"`      // why the file was quarantined
+	Pruned      int    `json:"pruned,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// GCReport summarizes a RuntimeGC pass.
+type GCReport struct {
+	DryRun bool           `json:"dry_run"`
+	Files  []GCFileReport `json:"files"`
+}
+
+// Changed reports whether the pass quarantined or pruned anything.
+func (r *GCReport) Changed() bool {
+	for _, f := range r.Files {
+		if f.Quarantined != "" || f.Pruned > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	stateFilesMu sync.Mutex
+	stateFiles   []StateFile
+)
+
+// RegisterStateFile adds a state file to the runtime GC registry.
+// Registering the same Name twice replaces the earlier entry.
+func RegisterStateFile(sf StateFile) {
+	stateFilesMu.Lock()
+	defer stateFilesMu.Unlock()
+	for i := range stateFiles {
+		if stateFiles[i].Name == sf.Name {
+			stateFiles[i] = sf
+			return
+		}
+	}
+	stateFiles = append(stateFiles, sf)
+}
+
+// registeredStateFiles returns a snapshot of the registry sorted by name.
+func registeredStateFiles() []StateFile {
+	stateFilesMu.Lock()
+	defer stateFilesMu.Unlock()
+	out := append([]StateFile(nil), stateFiles...)
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// RuntimeGC validates, prunes, and size-caps every registered quota state
+// file. Unreadable or oversized files are renamed to <path>.corrupt-<ts> so
+// their owner starts fresh instead of failing on every load.
+func RuntimeGC(townRoot string, now time.Time) (*GCReport, error) {
+	return runtimeGC(townRoot, now, false)
+}
+
+// PlanRuntimeGC reports what RuntimeGC would do without modifying anything.
+func PlanRuntimeGC(townRoot string, now time.Time) (*GCReport, error) {
+	return runtimeGC(townRoot, now, true)
+}
+
+func runtimeGC(townRoot string, now time.Time, dryRun bool) (*GCReport, error) {
+	if townRoot == "" {
+		return nil, errors.New("town root is required")
+	}
+	report := &GCReport{DryRun: dryRun}
+	for _, sf := range registeredStateFiles() {
+		report.Files = append(report.Files, gcStateFile(townRoot, sf, now, dryRun))
+	}
+	return report, nil
+}
+
+func gcStateFile(townRoot string, sf StateFile, now time.Time, dryRun bool) GCFileReport {
+	path := sf.Path(townRoot)
+	fr := GCFileReport{Name: sf.Name, Path: path}
+
+	if sf.Lock != nil && !dryRun {
+		unlock, err := sf.Lock(townRoot)
+		if err != nil {
+			fr.Error = err.Error()
+			return fr
+		}
+		defer unlock()
+	}
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path comes from a registered owner
+	if os.IsNotExist(err) {
+		fr.Missing = true
+		return fr
+	}
+	if err != nil {
+		fr.Error = err.Error()
+		return fr
+	}
+
+	quarantine := func(reason string) GCFileReport {
+		dest := fmt.Sprintf("%s.corrupt-%s", path, now.UTC().Format("20060102T150405Z"))
+		fr.Reason = reason
+		fr.Quarantined = dest
+		if !dryRun {
+			if err := os.Rename(path, dest); err != nil {
+				fr.Quarantined = ""
+				fr.Error = fmt.Sprintf("quarantining: %v", err)
+			}
+		}
+		return fr
+	}
+
+	if sf.Validate != nil {
+		if err := sf.Validate(data); err != nil {
+			return quarantine(err.Error())
+		}
+	}
+
+	if sf.Prune != nil {
+		out, removed, err := sf.Prune(data, now)
+		if err != nil {
+			fr.Error = fmt.Sprintf("pruning: %v", err)
+			return fr
+		}
+		if removed > 0 {
+			fr.Pruned = removed
+			data = out
+			if !dryRun {
+				if err := util.AtomicWriteFile(path, data, 0644); err != nil {
+					fr.Error = fmt.Sprintf("writing pruned state: %v", err)
+					return fr
+				}
+			}
+		}
+	}
+
+	if sf.MaxBytes > 0 && int64(len(data)) > sf.MaxBytes {
+		return quarantine(fmt.Sprintf("size %d exceeds cap %d", len(data), sf.MaxBytes))
+	}
+	return fr
+}
@@ -0,0 +1,246 @@
+package quota
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// withStateFiles replaces the GC registry for the duration of a test.
+func withStateFiles(t *testing.T, files ...StateFile) {
+	t.Helper()
+	stateFilesMu.Lock()
+	saved := stateFiles
+	stateFiles = nil
+	stateFilesMu.Unlock()
+	t.Cleanup(func() {
+		stateFilesMu.Lock()
+		stateFiles = saved
+		stateFilesMu.Unlock()
+	})
+	for _, sf := range files {
+		RegisterStateFile(sf)
+	}
+}
+
+// fakeSnoozes is a registered entry whose file is a JSON map of
+// name -> expiry (RFC3339).
+func fakeSnoozes(dir string) StateFile {
+	return StateFile{
+		Name: "snoozes",
+		Path: func(string) string { return filepath.Join(dir, "snoozes.json") },
+		Validate: func(data []byte) error {
+			var m map[string]time.Time
+			return json.Unmarshal(data, &m)
+		},
+		Prune: func(data []byte, now time.Time) ([]byte, int, error) {
+			var m map[string]time.Time
+			if err := json.Unmarshal(data, &m); err != nil {
+				return nil, 0, err
+			}
+			removed := 0
+			for k, exp := range m {
+				if now.After(exp) {
+					delete(m, k)
+					removed++
+				}
+			}
+			out, err := json.Marshal(m)
+			return out, removed, err
+		},
+	}
+}
+
+func TestRuntimeGC_QuarantinesCorruptQuotaState(t *testing.T) {
+	townRoot := setupTestTown(t)
+	path := constants.MayorQuotaPath(townRoot)
+	if err := os.WriteFile(path, []byte(`{"version": 1, "accounts": {`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The scan/rotate load path fails on every call until GC runs.
+	mgr := NewManager(townRoot)
+	if _, err := mgr.Load(); err == nil {
+		t.Fatal("expected Load to fail on corrupt state")
+	}
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	report, err := RuntimeGC(townRoot, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fr *GCFileReport
+	for i := range report.Files {
+		if report.Files[i].Name == "quota-state" {
+			fr = &report.Files[i]
+		}
+	}
+	if fr == nil || fr.Quarantined == "" {
+		t.Fatalf("expected quota-state to be quarantined, got %+v", report.Files)
+	}
+	if !strings.HasPrefix(filepath.Base(fr.Quarantined), "quota.json.corrupt-") {
+		t.Errorf("quarantine path = %q", fr.Quarantined)
+	}
+	if _, err := os.Stat(fr.Quarantined); err != nil {
+		t.Errorf("quarantined file missing: %v", err)
+	}
+
+	// After quarantine the load path starts fresh instead of erroring.
+	state, err := mgr.Load()
+	if err != nil {
+		t.Fatalf("Load after GC: %v", err)
+	}
+	if len(state.Accounts) != 0 {
+		t.Errorf("expected fresh state, got %+v", state.Accounts)
+	}
+}
+
+func TestRuntimeGC_PrunesExpiredQuotaLimits(t *testing.T) {
+	townRoot := setupTestTown(t)
+	mgr := NewManager(townRoot)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	state := &config.QuotaState{
+		Version: config.CurrentQuotaVersion,
+		Accounts: map[string]config.AccountQuotaState{
+			"expired": {Status: config.QuotaStatusLimited, ResetsAt: "11am (UTC)"},
+			"pending": {Status: config.QuotaStatusLimited, ResetsAt: "1pm (UTC)"},
+		},
+	}
+	if err := mgr.Save(state); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := RuntimeGC(townRoot, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Changed() {
+		t.Fatalf("expected a change, got %+v", report.Files)
+	}
+
+	got, err := mgr.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Accounts["expired"].Status != config.QuotaStatusAvailable {
+		t.Errorf("expired limit not pruned: %+v", got.Accounts["expired"])
+	}
+	if got.Accounts["pending"].Status != config.QuotaStatusLimited {
+		t.Errorf("pending limit pruned too early: %+v", got.Accounts["pending"])
+	}
+}
+
+func TestRuntimeGC_RegisteredEntries(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	capped := StateFile{
+		Name:     "episodes",
+		Path:     func(string) string { return filepath.Join(dir, "episodes.json") },
+		MaxBytes: 16,
+	}
+	failing := StateFile{
+		Name:  "locks",
+		Path:  func(string) string { return filepath.Join(dir, "locks.json") },
+		Prune: func([]byte, time.Time) ([]byte, int, error) { return nil, 0, errors.New("boom") },
+	}
+	absent := StateFile{
+		Name: "selections",
+		Path: func(string) string { return filepath.Join(dir, "missing.json") },
+	}
+	withStateFiles(t, fakeSnoozes(dir), capped, failing, absent)
+
+	snoozes := map[string]time.Time{"old": now.Add(-time.Minute), "new": now.Add(time.Hour)}
+	data, _ := json.Marshal(snoozes)
+	for name, content := range map[string][]byte{
+		"snoozes.json":  data,
+		"episodes.json": []byte(`{"padding": "more than sixteen bytes"}`),
+		"locks.json":    []byte(`{}`),
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := RuntimeGC("/town", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byName := map[string]GCFileReport{}
+	for _, f := range report.Files {
+		byName[f.Name] = f
+	}
+
+	if byName["snoozes"].Pruned != 1 {
+		t.Errorf("snoozes pruned = %d, want 1", byName["snoozes"].Pruned)
+	}
+	var left map[string]time.Time
+	raw, _ := os.ReadFile(filepath.Join(dir, "snoozes.json"))
+	if err := json.Unmarshal(raw, &left); err != nil || len(left) != 1 || left["new"].IsZero() {
+		t.Errorf("snoozes after prune = %v (%v)", left, err)
+	}
+
+	if byName["episodes"].Quarantined == "" {
+		t.Errorf("oversized episodes not quarantined: %+v", byName["episodes"])
+	}
+	if byName["locks"].Error == "" {
+		t.Errorf("expected prune error for locks: %+v", byName["locks"])
+	}
+	if !byName["selections"].Missing {
+		t.Errorf("expected selections missing: %+v", byName["selections"])
+	}
+}
+
+func TestPlanRuntimeGC_DoesNotMutate(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	corrupt := StateFile{
+		Name: "episodes",
+		Path: func(string) string { return filepath.Join(dir, "episodes.json") },
+		Validate: func(data []byte) error {
+			var v map[string]any
+			return json.Unmarshal(data, &v)
+		},
+	}
+	withStateFiles(t, fakeSnoozes(dir), corrupt)
+
+	snoozes, _ := json.Marshal(map[string]time.Time{"old": now.Add(-time.Minute)})
+	files := map[string][]byte{
+		"snoozes.json":  snoozes,
+		"episodes.json": []byte(`not json`),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := PlanRuntimeGC("/town", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.DryRun || !report.Changed() {
+		t.Fatalf("expected a dry-run report with changes, got %+v", report)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != len(files) {
+		t.Errorf("dry run created or removed files: %v", entries)
+	}
+	for name, want := range files {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil || string(got) != string(want) {
+			t.Errorf("%s modified by dry run: %q (%v)", name, got, err)
+		}
+	}
+}
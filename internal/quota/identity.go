@@ -0,0 +1,68 @@
+package quota
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// IdentityMismatch records that an account's credentials belong to a
+// different organization than the one configured in accounts.json.
+// This happens when a config dir registered under one handle is actually
+// logged into another Anthropic account.
+type IdentityMismatch struct {
+	ConfiguredOrgID string `json:"configured_org_id"` // Account.OrgID from accounts.json
+	ActualOrgID     string `json:"actual_org_id"`     // org the credentials belong to
+}
+
+// String returns a short human-readable description of the mismatch.
+func (m *IdentityMismatch) String() string {
+	return fmt.Sprintf("configured org %s, credentials belong to org %s", m.ConfiguredOrgID, m.ActualOrgID)
+}
+
+// ReadOrgID returns the organization UUID the credentials in configDir belong
+// to, read from oauthAccount.organizationUuid in <configDir>/.claude.json.
+// Returns "" with a nil error when the file or field is absent (not logged in
+// yet, or an older Claude Code that doesn't cache identity).
+func ReadOrgID(configDir string) (string, error) {
+	path := filepath.Join(util.ExpandHome(configDir), ".claude.json")
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var doc struct {
+		OAuthAccount struct {
+			OrganizationUUID string `json:"organizationUuid"`
+		} `json:"oauthAccount"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return "", fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return doc.OAuthAccount.OrganizationUUID, nil
+}
+
+// CheckAccountIdentity compares the account's configured OrgID with the org
+// the credentials in configDir actually belong to. Returns nil when they
+// agree or when there is nothing to compare (no configured OrgID, or the
+// config dir has no cached identity).
+func CheckAccountIdentity(acct config.Account, configDir string) (*IdentityMismatch, error) {
+	if acct.OrgID == "" {
+		return nil, nil
+	}
+	actual, err := ReadOrgID(configDir)
+	if err != nil {
+		return nil, err
+	}
+	if actual == "" || actual == acct.OrgID {
+		return nil, nil
+	}
+	return &IdentityMismatch{ConfiguredOrgID: acct.OrgID, ActualOrgID: actual}, nil
+}
@@ -0,0 +1,232 @@
+package quota
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// writeClaudeJSON creates a fixture config dir whose .claude.json reports
+// the given organization UUID. An empty orgID writes no oauthAccount.
+func writeClaudeJSON(t *testing.T, orgID string) string {
+	t.Helper()
+	dir := t.TempDir()
+	body := `{"numStartups": 3}`
+	if orgID != "" {
+		body = `{"oauthAccount": {"emailAddress": "x@example.com", "organizationUuid": "` + orgID + `"}}`
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".claude.json"), []byte(body), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestReadOrgID(t *testing.T) {
+	dir := writeClaudeJSON(t, "org-personal")
+	got, err := ReadOrgID(dir)
+	if err != nil {
+		t.Fatalf("ReadOrgID() error = %v", err)
+	}
+	if got != "org-personal" {
+		t.Errorf("ReadOrgID() = %q, want %q", got, "org-personal")
+	}
+
+	// Missing file and missing field both mean "unknown", not an error.
+	if got, err := ReadOrgID(t.TempDir()); err != nil || got != "" {
+		t.Errorf("ReadOrgID(empty dir) = (%q, %v), want (\"\", nil)", got, err)
+	}
+	if got, err := ReadOrgID(writeClaudeJSON(t, "")); err != nil || got != "" {
+		t.Errorf("ReadOrgID(no oauthAccount) = (%q, %v), want (\"\", nil)", got, err)
+	}
+
+	// Corrupt JSON is reported.
+	bad := t.TempDir()
+	if err := os.WriteFile(filepath.Join(bad, ".claude.json"), []byte("{not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadOrgID(bad); err == nil {
+		t.Error("ReadOrgID(corrupt) expected error")
+	}
+}
+
+func TestCheckAccountIdentity(t *testing.T) {
+	dir := writeClaudeJSON(t, "org-personal")
+
+	tests := []struct {
+		name         string
+		configuredID string
+		wantMismatch bool
+	}{
+		{"match", "org-personal", false},
+		{"mismatch", "org-work", true},
+		{"no configured org id", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := CheckAccountIdentity(config.Account{ConfigDir: dir, OrgID: tt.configuredID}, dir)
+			if err != nil {
+				t.Fatalf("CheckAccountIdentity() error = %v", err)
+			}
+			if (m != nil) != tt.wantMismatch {
+				t.Fatalf("CheckAccountIdentity() = %v, wantMismatch %v", m, tt.wantMismatch)
+			}
+			if m != nil && (m.ConfiguredOrgID != "org-work" || m.ActualOrgID != "org-personal") {
+				t.Errorf("mismatch = %+v, want configured org-work / actual org-personal", m)
+			}
+		})
+	}
+}
+
+func TestScanAll_FlagsIdentityMismatch(t *testing.T) {
+	setupTestRegistry(t)
+
+	workDir := writeClaudeJSON(t, "org-personal") // logged into the wrong account
+	personalDir := writeClaudeJSON(t, "org-personal")
+
+	tmux := &mockTmux{
+		sessions: []string{"gt-crew-bear", "gt-witness"},
+		paneContent: map[string]string{
+			"gt-crew-bear": "You've hit your limit · resets 7pm (America/Los_Angeles)",
+			"gt-witness":   "watching...",
+		},
+		envVars: map[string]map[string]string{
+			"gt-crew-bear": {"CLAUDE_CONFIG_DIR": workDir},
+			"gt-witness":   {"CLAUDE_CONFIG_DIR": personalDir},
+		},
+	}
+	accounts := &config.AccountsConfig{
+		Accounts: map[string]config.Account{
+			"work":     {ConfigDir: workDir, OrgID: "org-work"},
+			"personal": {ConfigDir: personalDir, OrgID: "org-personal"},
+		},
+	}
+
+	scanner, err := NewScanner(tmux, nil, accounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byName := map[string]ScanResult{}
+	for _, r := range results {
+		byName[r.Session] = r
+	}
+
+	bear := byName["gt-crew-bear"]
+	if bear.IdentityMismatch == nil {
+		t.Fatal("expected identity mismatch for gt-crew-bear")
+	}
+	if bear.IdentityMismatch.ConfiguredOrgID != "org-work" || bear.IdentityMismatch.ActualOrgID != "org-personal" {
+		t.Errorf("mismatch = %+v", bear.IdentityMismatch)
+	}
+	// The mismatch must not suppress the detection data.
+	if !bear.RateLimited || bear.AccountHandle != "work" {
+		t.Errorf("expected rate-limit data to be kept, got %+v", bear)
+	}
+
+	if byName["gt-witness"].IdentityMismatch != nil {
+		t.Errorf("unexpected mismatch for gt-witness: %+v", byName["gt-witness"].IdentityMismatch)
+	}
+}
+
+func TestScanAll_KeychainSwapSkipsIdentityCheck(t *testing.T) {
+	setupTestRegistry(t)
+
+	// The session's config dir still holds the old account's .claude.json,
+	// but GT_QUOTA_ACCOUNT says the swapped-in token belongs to "work".
+	oldDir := writeClaudeJSON(t, "org-personal")
+	workDir := writeClaudeJSON(t, "org-work")
+
+	tmux := &mockTmux{
+		sessions:    []string{"gt-crew-bear"},
+		paneContent: map[string]string{"gt-crew-bear": "working..."},
+		envVars: map[string]map[string]string{
+			"gt-crew-bear": {"CLAUDE_CONFIG_DIR": oldDir, "GT_QUOTA_ACCOUNT": "work"},
+		},
+	}
+	accounts := &config.AccountsConfig{
+		Accounts: map[string]config.Account{
+			"work":     {ConfigDir: workDir, OrgID: "org-work"},
+			"personal": {ConfigDir: oldDir, OrgID: "org-personal"},
+		},
+	}
+
+	scanner, err := NewScanner(tmux, nil, accounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].AccountHandle != "work" {
+		t.Fatalf("results = %+v, want one session on work", results)
+	}
+	if results[0].IdentityMismatch != nil {
+		t.Errorf("unexpected mismatch after keychain swap: %+v", results[0].IdentityMismatch)
+	}
+}
+
+func TestPlanRotation_ExcludesIdentityMismatch(t *testing.T) {
+	setupTestRegistry(t)
+
+	limitedDir := writeClaudeJSON(t, "org-limited")
+	crossedDir := writeClaudeJSON(t, "org-someone-else") // configured as org-crossed
+	unverifiedDir := writeClaudeJSON(t, "org-unverified")
+
+	tmux := &mockTmux{
+		sessions: []string{"gt-crew-bear"},
+		paneContent: map[string]string{
+			"gt-crew-bear": "You've hit your limit · resets 7pm (America/Los_Angeles)",
+		},
+		envVars: map[string]map[string]string{
+			"gt-crew-bear": {"CLAUDE_CONFIG_DIR": limitedDir},
+		},
+	}
+	accounts := &config.AccountsConfig{
+		Accounts: map[string]config.Account{
+			"limited":    {ConfigDir: limitedDir, OrgID: "org-limited"},
+			"crossed":    {ConfigDir: crossedDir, OrgID: "org-crossed"},
+			"unverified": {ConfigDir: unverifiedDir}, // no OrgID: nothing to compare
+		},
+	}
+
+	scanner, err := NewScanner(tmux, nil, accounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mgr := NewManager(setupTestTown(t))
+	state := &config.QuotaState{
+		Version: config.CurrentQuotaVersion,
+		Accounts: map[string]config.AccountQuotaState{
+			"limited":    {Status: config.QuotaStatusAvailable, LastUsed: "2025-01-01T03:00:00Z"},
+			"crossed":    {Status: config.QuotaStatusAvailable, LastUsed: "2025-01-01T01:00:00Z"},
+			"unverified": {Status: config.QuotaStatusAvailable, LastUsed: "2025-01-01T02:00:00Z"},
+		},
+	}
+	if err := mgr.Save(state); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := PlanRotation(scanner, mgr, accounts, PlanOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, h := range plan.AvailableAccounts {
+		if h == "crossed" {
+			t.Errorf("mismatched account must not be a rotation candidate: %v", plan.AvailableAccounts)
+		}
+	}
+	if _, ok := plan.SkippedAccounts["crossed"]; !ok {
+		t.Errorf("expected crossed in SkippedAccounts, got %v", plan.SkippedAccounts)
+	}
+	if got := plan.Assignments["gt-crew-bear"]; got != "unverified" {
+		t.Errorf("gt-crew-bear assigned %q, want %q", got, "unverified")
+	}
+}
@@ -4,7 +4,6 @@ package quota
 
 import (
 	"crypto/sha256"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -216,35 +215,15 @@ func ValidateKeychainToken(configDir string) error {
 		return nil
 	}
 
-	// Strategy 1: Parse as JSON credential with expires_at field.
-	// Claude Code may store the full OAuth response including expiry.
-	var cred struct {
-		ExpiresAt int64 `json:"expires_at"`
-	}
-	if json.Unmarshal([]byte(raw), &cred) == nil && cred.ExpiresAt > 0 {
-		if time.Now().Unix() >= cred.ExpiresAt {
-			return fmt.Errorf("token expired at %s", time.Unix(cred.ExpiresAt, 0).Format(time.RFC3339))
+	// Local validation: a JSON credential with expires_at, or a JWT exp claim.
+	tok, err := ParseKeychainToken(raw)
+	if err == nil {
+		if tok.IsExpired() {
+			return fmt.Errorf("token expired at %s", tok.ExpiresAt.Format(time.RFC3339))
 		}
 		return nil
 	}
 
-	// Strategy 2: Parse as JWT — decode payload, check exp claim.
-	parts := strings.Split(raw, ".")
-	if len(parts) == 3 {
-		payload, decErr := base64.RawURLEncoding.DecodeString(parts[1])
-		if decErr == nil {
-			var claims struct {
-				Exp int64 `json:"exp"`
-			}
-			if json.Unmarshal(payload, &claims) == nil && claims.Exp > 0 {
-				if time.Now().Unix() >= claims.Exp {
-					return fmt.Errorf("JWT expired at %s", time.Unix(claims.Exp, 0).Format(time.RFC3339))
-				}
-				return nil
-			}
-		}
-	}
-
 	// Token is present but format is opaque (not JSON with expires_at, not JWT).
 	// Claude Code uses OAuth tokens that authenticate through a different flow
 	// than Bearer tokens against the Anthropic API, so HTTP validation would
@@ -0,0 +1,109 @@
+package quota
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// patternEntry is one line of a JSONL pattern file.
+type patternEntry struct {
+	Pattern string `json:"pattern"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// LoadPatternsFromFile reads rate-limit patterns from path. Each non-blank
+// line is either a JSON object ({"pattern":"...","comment":"..."}) or a
+// plain pattern; plain lines starting with # are comments. The two forms
+// may be mixed. Patterns are returned uncompiled, in file order.
+func LoadPatternsFromFile(path string) ([]string, error) {
+	f, err := os.Open(path) //nolint:gosec // G304: path is operator-configured
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var patterns []string
+	sc := bufio.NewScanner(f)
+	line := 0
+	for sc.Scan() {
+		line++
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if strings.HasPrefix(text, "{") {
+			var e patternEntry
+			if err := json.Unmarshal([]byte(text), &e); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+			}
+			if e.Pattern == "" {
+				return nil, fmt.Errorf("%s:%d: missing \"pattern\"", path, line)
+			}
+			text = e.Pattern
+		}
+		patterns = append(patterns, text)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return patterns, nil
+}
+
+// WithPatternFile replaces the scanner's hard rate-limit patterns with those
+// in path (see LoadPatternsFromFile). The file is remembered: ScanAll
+// reloads it whenever its modification time or size changes. On error the
+// current patterns are kept.
+func (s *Scanner) WithPatternFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	patterns, err := LoadPatternsFromFile(path)
+	if err != nil {
+		return err
+	}
+	if len(patterns) == 0 {
+		return fmt.Errorf("%s: no patterns", path)
+	}
+	compiled, err := compilePatterns(patterns)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	s.patterns = compiled
+	s.patternFile = path
+	s.patternFileMod = info.ModTime()
+	s.patternFileSize = info.Size()
+	return nil
+}
+
+// reloadPatternFileIfChanged re-applies the pattern file when it has changed
+// since it was last loaded. Errors (e.g. a half-written file) leave the
+// current patterns in place; the reload is retried on the next scan.
+func (s *Scanner) reloadPatternFileIfChanged() {
+	if s.patternFile == "" {
+		return
+	}
+	info, err := os.Stat(s.patternFile)
+	if err != nil || (info.ModTime().Equal(s.patternFileMod) && info.Size() == s.patternFileSize) {
+		return
+	}
+	_ = s.WithPatternFile(s.patternFile)
+}
+
+// compilePatterns compiles rate-limit patterns case-insensitively.
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		re, err := regexp.Compile("(?i)" + p)
+		if err != nil {
+			return nil, fmt.Errorf("compiling pattern %q: %w", p, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
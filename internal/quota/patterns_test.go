@@ -0,0 +1,105 @@
+package quota
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func writePatternFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadPatternsFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "patterns")
+	writePatternFile(t, path, `# plain patterns
+Weekly cap reached
+
+{"pattern":"quota exhausted","comment":"new TUI message"}
+  Try again in \d+ hours  
+`)
+
+	got, err := LoadPatternsFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadPatternsFromFile: %v", err)
+	}
+	want := []string{"Weekly cap reached", "quota exhausted", `Try again in \d+ hours`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("patterns = %q, want %q", got, want)
+	}
+}
+
+func TestLoadPatternsFromFile_Errors(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := LoadPatternsFromFile(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected error for missing file")
+	}
+
+	for name, content := range map[string]string{
+		"bad-json":      `{"pattern":`,
+		"empty-pattern": `{"comment":"no pattern"}`,
+	} {
+		path := filepath.Join(dir, name)
+		writePatternFile(t, path, content+"\n")
+		if _, err := LoadPatternsFromFile(path); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestScanner_WithPatternFile(t *testing.T) {
+	setupTestRegistry(t)
+	tmux := &mockTmux{
+		sessions:    []string{"gt-crew-bear"},
+		paneContent: map[string]string{"gt-crew-bear": "working...\nWeekly cap reached, come back Monday\n"},
+	}
+	scanner, err := NewScanner(tmux, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, _ := scanner.ScanAll()
+	if results[0].RateLimited {
+		t.Fatal("default patterns should not match the custom message")
+	}
+
+	path := filepath.Join(t.TempDir(), "patterns.txt")
+	writePatternFile(t, path, "Weekly cap reached\n")
+	if err := scanner.WithPatternFile(path); err != nil {
+		t.Fatalf("WithPatternFile: %v", err)
+	}
+	results, _ = scanner.ScanAll()
+	if !results[0].RateLimited {
+		t.Error("custom pattern should detect the rate limit")
+	}
+
+	// ScanAll picks up edits to the file.
+	writePatternFile(t, path, "something else entirely\n")
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, future, future); err != nil {
+		t.Fatal(err)
+	}
+	results, _ = scanner.ScanAll()
+	if results[0].RateLimited {
+		t.Error("ScanAll should have reloaded the changed pattern file")
+	}
+}
+
+func TestScanner_WithPatternFile_KeepsPatternsOnError(t *testing.T) {
+	scanner, err := NewScanner(&mockTmux{}, []string{"original"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "patterns.txt")
+	writePatternFile(t, path, "([unclosed\n")
+	if err := scanner.WithPatternFile(path); err == nil {
+		t.Fatal("expected error for invalid regexp")
+	}
+	if len(scanner.patterns) != 1 || scanner.patterns[0].String() != "(?i)original" {
+		t.Errorf("patterns changed after failed load: %v", scanner.patterns)
+	}
+}
@@ -2,6 +2,7 @@ package quota
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/util"
@@ -15,6 +16,7 @@ type RotateResult struct {
 	Rotated        bool   `json:"rotated"`                  // whether rotation occurred
 	ResumedSession string `json:"resumed_session,omitempty"` // session ID that was resumed (empty if fresh start)
 	KeychainSwap   bool   `json:"keychain_swap,omitempty"`   // whether keychain was swapped
+	ConfigDir      string `json:"config_dir,omitempty"`      // CLAUDE_CONFIG_DIR the session runs with (kept across rotation)
 	Error          string `json:"error,omitempty"`          // error message if rotation failed
 }
 
@@ -39,7 +41,8 @@ type RotatePlan struct {
 	ConfigDirSwaps map[string]string
 
 	// SkippedAccounts maps handle -> reason for accounts that were
-	// available by quota status but had invalid/expired tokens.
+	// available by quota status but had invalid/expired tokens or
+	// credentials belonging to a different org than configured.
 	SkippedAccounts map[string]string `json:"skipped_accounts,omitempty"`
 }
 
@@ -67,6 +70,9 @@ func PlanRotation(scanner *Scanner, mgr *Manager, acctCfg *config.AccountsConfig
 		return nil, fmt.Errorf("scanning sessions: %w", err)
 	}
 
+	// Share the scan with quick checks (gt quota assert). Best-effort.
+	_ = mgr.SaveScanCache(results, time.Now())
+
 	// Load quota state
 	state, err := mgr.Load()
 	if err != nil {
@@ -112,6 +118,15 @@ func PlanRotation(scanner *Scanner, mgr *Manager, acctCfg *config.AccountsConfig
 	// The caller persists confirmed rate-limit state after execution.
 	available := mgr.AvailableAccounts(state)
 
+	// Accounts whose sessions reported an identity mismatch are not
+	// rotation candidates: their usage numbers belong to another org.
+	mismatched := make(map[string]*IdentityMismatch)
+	for _, r := range results {
+		if r.IdentityMismatch != nil && r.AccountHandle != "" {
+			mismatched[r.AccountHandle] = r.IdentityMismatch
+		}
+	}
+
 	// Validate tokens for available accounts — skip accounts with expired or
 	// revoked tokens. This prevents swapping a bad token into the target's
 	// keychain entry, which would leave the session non-functional.
@@ -126,6 +141,14 @@ func PlanRotation(scanner *Scanner, mgr *Manager, acctCfg *config.AccountsConfig
 			continue
 		}
 		configDir := util.ExpandHome(acct.ConfigDir)
+		if m := mismatched[handle]; m != nil {
+			skipped[handle] = "identity mismatch: " + m.String()
+			continue
+		}
+		if m, err := CheckAccountIdentity(acct, configDir); err == nil && m != nil {
+			skipped[handle] = "identity mismatch: " + m.String()
+			continue
+		}
 		if err := ValidateKeychainToken(configDir); err != nil {
 			skipped[handle] = err.Error()
 			continue
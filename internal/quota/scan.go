@@ -1,10 +1,12 @@
 package quota
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
@@ -14,13 +16,62 @@ import (
 
 // ScanResult holds the result of scanning a single tmux session.
 type ScanResult struct {
-	Session       string    `json:"session"`                  // tmux session name
-	AccountHandle string    `json:"account_handle,omitempty"` // resolved account handle
-	ConfigDir     string    `json:"config_dir,omitempty"`     // CLAUDE_CONFIG_DIR (even if account unknown)
-	RateLimited   bool      `json:"rate_limited"`             // whether hard rate-limit was detected
-	NearLimit     bool      `json:"near_limit"`               // whether approaching-limit signal was detected
-	MatchedLine   string    `json:"matched_line,omitempty"`   // the line that matched (hard or warning)
-	ResetsAt      string    `json:"resets_at,omitempty"`      // parsed reset time if available
+	Session       string     `json:"session"`                  // tmux session name
+	Prefix        string     `json:"prefix,omitempty"`         // matched Gas Town prefix, e.g. "gt-"
+	AccountHandle string     `json:"account_handle,omitempty"` // resolved account handle
+	ConfigDir     string     `json:"config_dir,omitempty"`     // CLAUDE_CONFIG_DIR (even if account unknown)
+	RateLimited   bool       `json:"rate_limited"`             // whether hard rate-limit was detected
+	NearLimit     bool       `json:"near_limit"`               // whether approaching-limit signal was detected
+	MatchedLine   string     `json:"matched_line,omitempty"`   // the line that matched (hard or warning)
+	ResetsAt      string     `json:"resets_at,omitempty"`      // parsed reset time if available
+	ResetsAtTime  *time.Time `json:"resets_at_time,omitempty"` // ResetsAt as an instant, if parseable
+
+	// IdentityMismatch is set when the session's credentials belong to a
+	// different org than the resolved account's configured OrgID.
+	// Usage data is still reported, but the account is excluded from
+	// automatic rotation candidacy.
+	IdentityMismatch *IdentityMismatch `json:"identity_mismatch,omitempty"`
+
+	// Error is set when scanning the session failed (e.g. a panic in an
+	// analyzer). The other detection fields are then not meaningful.
+	Error string `json:"error,omitempty"`
+}
+
+// scanResultFields is ScanResult without its JSON methods.
+type scanResultFields ScanResult
+
+// MarshalJSON encodes ResetsAtTime as a second-precision RFC 3339 string
+// (e.g. "2026-02-28T19:00:00-08:00") so shell consumers get a stable,
+// parseable timestamp.
+func (r ScanResult) MarshalJSON() ([]byte, error) {
+	var resetsAtTime string
+	if r.ResetsAtTime != nil {
+		resetsAtTime = r.ResetsAtTime.Format(time.RFC3339)
+	}
+	return json.Marshal(struct {
+		scanResultFields
+		ResetsAtTime string `json:"resets_at_time,omitempty"`
+	}{scanResultFields(r), resetsAtTime})
+}
+
+// UnmarshalJSON is the inverse of MarshalJSON.
+func (r *ScanResult) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*scanResultFields
+		ResetsAtTime string `json:"resets_at_time,omitempty"`
+	}{scanResultFields: (*scanResultFields)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.ResetsAtTime = nil
+	if aux.ResetsAtTime != "" {
+		t, err := time.Parse(time.RFC3339, aux.ResetsAtTime)
+		if err != nil {
+			return fmt.Errorf("parsing resets_at_time: %w", err)
+		}
+		r.ResetsAtTime = &t
+	}
+	return nil
 }
 
 // TmuxClient is the interface for tmux operations needed by the scanner.
@@ -37,6 +88,29 @@ type Scanner struct {
 	patterns        []*regexp.Regexp // hard rate-limit patterns
 	warningPatterns []*regexp.Regexp // near-limit warning patterns
 	accounts        *config.AccountsConfig
+
+	// Pattern file set by WithPatternFile, reloaded by ScanAll on change.
+	patternFile     string
+	patternFileMod  time.Time
+	patternFileSize int64
+
+	// analyzer set by WithAnalyzer, consulted when no pattern matches.
+	analyzer ContentAnalyzer
+}
+
+// ContentAnalyzer classifies pane content the regex patterns did not match,
+// e.g. by asking a local model. content is the bottom checkLines of the pane.
+// matchedLine is reported as ScanResult.MatchedLine when a limit is found.
+type ContentAnalyzer interface {
+	Analyze(content string) (rateLimit bool, nearLimit bool, matchedLine string, err error)
+}
+
+// NoopAnalyzer is a ContentAnalyzer that never detects anything.
+type NoopAnalyzer struct{}
+
+// Analyze implements ContentAnalyzer.
+func (NoopAnalyzer) Analyze(string) (bool, bool, string, error) {
+	return false, false, "", nil
 }
 
 // NewScanner creates a scanner with the given tmux client and rate-limit patterns.
@@ -46,13 +120,9 @@ func NewScanner(tmux TmuxClient, patterns []string, accounts *config.AccountsCon
 		patterns = constants.DefaultRateLimitPatterns
 	}
 
-	compiled := make([]*regexp.Regexp, 0, len(patterns))
-	for _, p := range patterns {
-		re, err := regexp.Compile("(?i)" + p)
-		if err != nil {
-			return nil, fmt.Errorf("compiling pattern %q: %w", p, err)
-		}
-		compiled = append(compiled, re)
+	compiled, err := compilePatterns(patterns)
+	if err != nil {
+		return nil, err
 	}
 
 	return &Scanner{
@@ -81,6 +151,13 @@ func (s *Scanner) WithWarningPatterns(patterns []string) error {
 	return nil
 }
 
+// WithAnalyzer sets a ContentAnalyzer to run as a fallback when neither the
+// rate-limit nor the warning patterns match a session's pane. Pass nil to
+// remove it.
+func (s *Scanner) WithAnalyzer(a ContentAnalyzer) {
+	s.analyzer = a
+}
+
 // scanLines is the number of pane lines to capture for rate-limit detection.
 // We capture a generous window but only check the bottom checkLines for
 // rate-limit patterns — if the limit was resolved, subsequent output pushes
@@ -98,6 +175,8 @@ const checkLines = 20
 // ScanAll scans all Gas Town tmux sessions for rate-limit and near-limit indicators.
 // Returns results for all Gas Town sessions.
 func (s *Scanner) ScanAll() ([]ScanResult, error) {
+	s.reloadPatternFileIfChanged()
+
 	sessions, err := s.tmux.ListSessions()
 	if err != nil {
 		return nil, fmt.Errorf("listing sessions: %w", err)
@@ -109,16 +188,47 @@ func (s *Scanner) ScanAll() ([]ScanResult, error) {
 			continue
 		}
 
-		result := s.scanSession(sess)
-		results = append(results, result)
+		results = append(results, s.safeScanSession(sess))
 	}
 
 	return results, nil
 }
 
+// ScanSession scans a single session. Unlike ScanAll it reports an error
+// when the session does not exist, so callers asking about one specific
+// session can tell "not limited" from "not running".
+func (s *Scanner) ScanSession(session string) (ScanResult, error) {
+	sessions, err := s.tmux.ListSessions()
+	if err != nil {
+		return ScanResult{}, fmt.Errorf("listing sessions: %w", err)
+	}
+	for _, sess := range sessions {
+		if sess == session {
+			return s.safeScanSession(session), nil
+		}
+	}
+	return ScanResult{}, fmt.Errorf("session %q not found", session)
+}
+
+// safeScanSession is scanSession with panics recovered into
+// ScanResult.Error, so one bad session can't abort a whole scan.
+func (s *Scanner) safeScanSession(session string) (result ScanResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = ScanResult{
+				Session: session,
+				Prefix:  gasTownPrefix(session),
+				Error:   fmt.Sprintf("panic scanning session: %v", r),
+			}
+		}
+	}()
+	return s.scanSession(session)
+}
+
 // scanSession examines a single tmux session for rate-limit and near-limit indicators.
 func (s *Scanner) scanSession(session string) ScanResult {
 	result := ScanResult{Session: session}
+	result.Prefix = gasTownPrefix(session)
 
 	// Always capture CLAUDE_CONFIG_DIR for rotation planning, even if
 	// the account handle can't be resolved (unknown account sessions).
@@ -133,7 +243,20 @@ func (s *Scanner) scanSession(session string) ScanResult {
 	}
 
 	// Derive account from CLAUDE_CONFIG_DIR
-	result.AccountHandle = s.resolveAccountHandle(session)
+	handle, swapped := s.resolveAccountHandle(session)
+	result.AccountHandle = handle
+
+	// Cross-check the resolved account against the identity the
+	// credentials actually carry. Best-effort: unreadable identity is
+	// treated as "nothing to compare". After a keychain swap the config
+	// dir's .claude.json still describes the previous account, so there
+	// is nothing meaningful to compare against.
+	if result.AccountHandle != "" && result.ConfigDir != "" && !swapped {
+		acct := s.accounts.Accounts[result.AccountHandle]
+		if mismatch, err := CheckAccountIdentity(acct, result.ConfigDir); err == nil {
+			result.IdentityMismatch = mismatch
+		}
+	}
 
 	// Capture pane content
 	content, err := s.tmux.CapturePane(session, scanLines)
@@ -163,6 +286,9 @@ func (s *Scanner) scanSession(session string) ScanResult {
 				result.RateLimited = true
 				result.MatchedLine = line
 				result.ResetsAt = parseResetTime(line)
+				if t, ok := ParseResetTimeAbsolute(result.ResetsAt); ok {
+					result.ResetsAtTime = &t
+				}
 				return result
 			}
 		}
@@ -185,15 +311,37 @@ func (s *Scanner) scanSession(session string) ScanResult {
 		}
 	}
 
+	// Nothing matched — let the analyzer, if any, have a look. Analyzer
+	// errors are treated as "no signal", like an uncapturable pane.
+	if s.analyzer != nil {
+		rateLimit, nearLimit, matched, err := s.analyzer.Analyze(strings.Join(bottomLines, "\n"))
+		if err != nil {
+			return result
+		}
+		switch {
+		case rateLimit:
+			result.RateLimited = true
+			result.MatchedLine = matched
+			result.ResetsAt = parseResetTime(matched)
+			if t, ok := ParseResetTimeAbsolute(result.ResetsAt); ok {
+				result.ResetsAtTime = &t
+			}
+		case nearLimit:
+			result.NearLimit = true
+			result.MatchedLine = matched
+		}
+	}
+
 	return result
 }
 
 // resolveAccountHandle maps a session's active account back to a handle.
 // Checks GT_QUOTA_ACCOUNT first (set by keychain swap rotation), then
 // falls back to matching CLAUDE_CONFIG_DIR against registered accounts.
-func (s *Scanner) resolveAccountHandle(session string) string {
+// swapped reports that the handle came from GT_QUOTA_ACCOUNT.
+func (s *Scanner) resolveAccountHandle(session string) (handle string, swapped bool) {
 	if s.accounts == nil {
-		return ""
+		return "", false
 	}
 
 	// After keychain swap, the config dir still maps to the old account.
@@ -202,25 +350,25 @@ func (s *Scanner) resolveAccountHandle(session string) string {
 		override = strings.TrimSpace(override)
 		if override != "" {
 			if _, ok := s.accounts.Accounts[override]; ok {
-				return override
+				return override, true
 			}
 		}
 	}
 
 	configDir, err := s.tmux.GetEnvironment(session, "CLAUDE_CONFIG_DIR")
 	if err != nil {
-		return "" // No CLAUDE_CONFIG_DIR = using default config
+		return "", false // No CLAUDE_CONFIG_DIR = using default config
 	}
 
 	configDir = strings.TrimSpace(configDir)
 	for handle, acct := range s.accounts.Accounts {
 		// Compare normalized paths (accounts may use ~/... while tmux has expanded)
 		if acct.ConfigDir == configDir || util.ExpandHome(acct.ConfigDir) == configDir {
-			return handle
+			return handle, false
 		}
 	}
 
-	return "" // CLAUDE_CONFIG_DIR doesn't match any registered account
+	return "", false // CLAUDE_CONFIG_DIR doesn't match any registered account
 }
 
 // isGasTownSession returns true if the session name belongs to Gas Town.
@@ -230,6 +378,12 @@ func isGasTownSession(sess string) bool {
 	return session.IsKnownSession(sess)
 }
 
+// gasTownPrefix returns the matched Gas Town prefix of a session name, or "".
+func gasTownPrefix(sess string) string {
+	prefix, _ := session.MatchSession(sess)
+	return prefix
+}
+
 // parseResetTime attempts to extract the reset time from a rate-limit message.
 // Examples:
 //
@@ -0,0 +1,91 @@
+package quota
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/buildinfo"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// DefaultScanCacheMaxAge is how long a cached scan is trusted by quick
+// checks such as `gt quota assert`. The quota dog rescans every 5 minutes.
+const DefaultScanCacheMaxAge = 10 * time.Minute
+
+// ScanCache is the most recent full scan, persisted so that quick checks
+// can answer without touching tmux.
+type ScanCache struct {
+	ScannedAt  time.Time             `json:"scanned_at"`
+	Results    []ScanResult          `json:"results"`
+	Provenance *buildinfo.Provenance `json:"provenance,omitempty"`
+}
+
+// Fresh reports whether the cache is no older than maxAge at now.
+func (c *ScanCache) Fresh(now time.Time, maxAge time.Duration) bool {
+	return c != nil && !c.ScannedAt.IsZero() && now.Sub(c.ScannedAt) <= maxAge
+}
+
+// Lookup returns the cached result for session.
+func (c *ScanCache) Lookup(session string) (ScanResult, bool) {
+	if c == nil {
+		return ScanResult{}, false
+	}
+	for _, r := range c.Results {
+		if r.Session == session {
+			return r, true
+		}
+	}
+	return ScanResult{}, false
+}
+
+// scanCachePath returns the path to the cached scan results.
+func scanCachePath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirMayor, constants.DirRuntime, "quota-scan.json")
+}
+
+// SaveScanCache records results as the latest full scan.
+func (m *Manager) SaveScanCache(results []ScanResult, now time.Time) error {
+	cache := &ScanCache{
+		ScannedAt:  now.UTC(),
+		Results:    results,
+		Provenance: buildinfo.Stamp(buildinfo.ConfigFingerprint(constants.MayorAccountsPath(m.townRoot))),
+	}
+	return util.EnsureDirAndWriteJSON(scanCachePath(m.townRoot), cache)
+}
+
+// LoadScanCache returns the latest cached scan, or nil if none exists.
+func (m *Manager) LoadScanCache() (*ScanCache, error) {
+	path := scanCachePath(m.townRoot)
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is under the town root
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading scan cache: %w", err)
+	}
+	var cache ScanCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("parsing scan cache: %w", err)
+	}
+	buildinfo.NoteLoaded(path, cache.Provenance)
+	return &cache, nil
+}
+
+func init() {
+	RegisterStateFile(StateFile{
+		Name: "scan-cache",
+		Path: scanCachePath,
+		Validate: func(data []byte) error {
+			var cache ScanCache
+			if err := json.Unmarshal(data, &cache); err != nil {
+				return fmt.Errorf("parsing scan cache: %w", err)
+			}
+			return nil
+		},
+		MaxBytes: 1 << 20,
+	})
+}
@@ -1,8 +1,12 @@
 package quota
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/session"
@@ -137,6 +141,9 @@ func TestScanAll_DetectsRateLimited(t *testing.T) {
 	if mayor.ResetsAt != "7pm (America/Los_Angeles)" {
 		t.Errorf("expected resets at '7pm (America/Los_Angeles)', got %q", mayor.ResetsAt)
 	}
+	if mayor.ResetsAtTime == nil || !mayor.ResetsAtTime.After(time.Now()) {
+		t.Errorf("expected ResetsAtTime to be the next 7pm, got %v", mayor.ResetsAtTime)
+	}
 
 	// gt-crew-bear should NOT be rate-limited
 	crew := resultMap["gt-crew-bear"]
@@ -183,6 +190,30 @@ func TestScanAll_SkipsNonGasTownSessions(t *testing.T) {
 	}
 }
 
+func TestScanAll_PopulatesPrefix(t *testing.T) {
+	setupTestRegistry(t)
+
+	tmux := &mockTmux{sessions: []string{"hq-mayor", "gt-crew-bear", "bd-refinery"}}
+	scanner, err := NewScanner(tmux, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"hq-mayor": "hq-", "gt-crew-bear": "gt-", "bd-refinery": "bd-"}
+	if len(results) != len(want) {
+		t.Fatalf("expected %d results, got %d", len(want), len(results))
+	}
+	for _, r := range results {
+		if r.Prefix != want[r.Session] {
+			t.Errorf("%s: Prefix = %q, want %q", r.Session, r.Prefix, want[r.Session])
+		}
+	}
+}
+
 // TestScanAll_DetectsRateLimitTUIPrompt verifies detection when the original
 // "You've hit your limit" message has scrolled off, leaving only the
 // interactive /rate-limit-options TUI prompt visible in the capture window.
@@ -315,6 +346,137 @@ func TestScanAll_CustomPatterns(t *testing.T) {
 	}
 }
 
+// markerAnalyzer is a ContentAnalyzer that reports a hard limit when the
+// pane contains marker.
+type markerAnalyzer struct {
+	marker string
+}
+
+func (a markerAnalyzer) Analyze(content string) (bool, bool, string, error) {
+	for _, line := range strings.Split(content, "\n") {
+		if strings.Contains(line, a.marker) {
+			return true, false, strings.TrimSpace(line), nil
+		}
+	}
+	return false, false, "", nil
+}
+
+func TestScanAll_AnalyzerFallback(t *testing.T) {
+	setupTestRegistry(t)
+
+	tmux := &mockTmux{
+		sessions: []string{"gt-crew-odd", "gt-crew-fine"},
+		paneContent: map[string]string{
+			"gt-crew-odd":  "working...\nQUOTA-EXHAUSTED-XYZZY resets 7pm\n",
+			"gt-crew-fine": "working...\n",
+		},
+	}
+
+	scanner, err := NewScanner(tmux, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The regex patterns alone don't recognise the marker.
+	results, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if r.RateLimited {
+			t.Fatalf("%s rate-limited without an analyzer", r.Session)
+		}
+	}
+
+	scanner.WithAnalyzer(markerAnalyzer{marker: "QUOTA-EXHAUSTED-XYZZY"})
+	results, err = scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]ScanResult)
+	for _, r := range results {
+		got[r.Session] = r
+	}
+	odd := got["gt-crew-odd"]
+	if !odd.RateLimited || odd.MatchedLine != "QUOTA-EXHAUSTED-XYZZY resets 7pm" || odd.ResetsAt != "7pm" {
+		t.Errorf("gt-crew-odd = %+v, want rate-limited by analyzer", odd)
+	}
+	if got["gt-crew-fine"].RateLimited {
+		t.Error("gt-crew-fine should not be rate-limited")
+	}
+
+	scanner.WithAnalyzer(NoopAnalyzer{})
+	results, err = scanner.ScanAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if r.RateLimited || r.NearLimit {
+			t.Errorf("NoopAnalyzer flagged %s", r.Session)
+		}
+	}
+}
+
+// panicAnalyzer panics when analyzing the pane of one session, identified
+// by a marker in its content.
+type panicAnalyzer struct {
+	marker string
+}
+
+func (a panicAnalyzer) Analyze(content string) (bool, bool, string, error) {
+	if strings.Contains(content, a.marker) {
+		var m map[string]int
+		m["boom"]++ // nil map write
+	}
+	return false, false, "", nil
+}
+
+func TestScanAll_RecoversFromPanic(t *testing.T) {
+	setupTestRegistry(t)
+
+	tmux := &mockTmux{
+		sessions: []string{"gt-crew-a", "gt-crew-bad", "gt-crew-b"},
+		paneContent: map[string]string{
+			"gt-crew-a":   "working...",
+			"gt-crew-bad": "pane of gt-crew-bad",
+			"gt-crew-b":   "You've hit your limit · resets 7pm",
+		},
+	}
+
+	scanner, err := NewScanner(tmux, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner.WithAnalyzer(panicAnalyzer{marker: "gt-crew-bad"})
+
+	results, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatalf("ScanAll: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d: %+v", len(results), results)
+	}
+	got := make(map[string]ScanResult)
+	for _, r := range results {
+		got[r.Session] = r
+	}
+	if bad := got["gt-crew-bad"]; bad.Error == "" || !strings.Contains(bad.Error, "panic") {
+		t.Errorf("gt-crew-bad Error = %q, want panic message", bad.Error)
+	}
+	if got["gt-crew-a"].Error != "" || got["gt-crew-b"].Error != "" {
+		t.Errorf("healthy sessions have errors: %+v", results)
+	}
+	if !got["gt-crew-b"].RateLimited {
+		t.Error("gt-crew-b after the panicking session should still be scanned")
+	}
+
+	// ScanSession recovers too.
+	one, err := scanner.ScanSession("gt-crew-bad")
+	if err != nil || one.Error == "" {
+		t.Errorf("ScanSession(gt-crew-bad) = (%+v, %v), want result with Error", one, err)
+	}
+}
+
 func TestScanAll_CaptureError(t *testing.T) {
 	setupTestRegistry(t)
 
@@ -625,3 +787,68 @@ func TestWithWarningPatterns_InvalidPattern(t *testing.T) {
 		t.Error("expected error for invalid warning pattern")
 	}
 }
+
+func TestScanResultJSONRoundTrip(t *testing.T) {
+	resets := time.Date(2026, 2, 28, 19, 0, 0, 0, time.FixedZone("PST", -8*3600))
+	orig := ScanResult{
+		Session:       "gt-crew-bear",
+		Prefix:        "gt-",
+		AccountHandle: "work",
+		ConfigDir:     "/home/me/.claude-accounts/work",
+		RateLimited:   true,
+		NearLimit:     true,
+		MatchedLine:   "You've hit your limit · resets 7pm (America/Los_Angeles)",
+		ResetsAt:      "7pm (America/Los_Angeles)",
+		ResetsAtTime:  &resets,
+		IdentityMismatch: &IdentityMismatch{
+			ConfiguredOrgID: "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0",
+			ActualOrgID:     "11111111-2222-3333-4444-555555555555",
+		},
+	}
+
+	first, err := json.Marshal(orig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(first), `"resets_at_time":"2026-02-28T19:00:00-08:00"`) {
+		t.Errorf("resets_at_time not RFC 3339: %s", first)
+	}
+
+	var decoded ScanResult
+	if err := json.Unmarshal(first, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.ResetsAtTime == nil || !decoded.ResetsAtTime.Equal(resets) {
+		t.Errorf("ResetsAtTime = %v, want %v", decoded.ResetsAtTime, resets)
+	}
+	second, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Errorf("round trip changed JSON:\n first: %s\nsecond: %s", first, second)
+	}
+
+	// Slices of results (as printed by gt quota scan --json) use the same encoding.
+	list, err := json.Marshal([]ScanResult{orig})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "[" + string(first) + "]"; string(list) != want {
+		t.Errorf("slice encoding = %s, want %s", list, want)
+	}
+}
+
+func TestScanResultJSON_NoResetTime(t *testing.T) {
+	data, err := json.Marshal(ScanResult{Session: "gt-x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "resets_at_time") {
+		t.Errorf("empty ResetsAtTime should be omitted: %s", data)
+	}
+	var r ScanResult
+	if err := json.Unmarshal([]byte(`{"session":"gt-x","resets_at_time":"tomorrow"}`), &r); err == nil {
+		t.Error("expected error for non-RFC 3339 resets_at_time")
+	}
+}
@@ -6,6 +6,7 @@
 package quota
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -15,6 +16,7 @@ import (
 	"time"
 
 	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/buildinfo"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/util"
@@ -35,6 +37,13 @@ func (m *Manager) statePath() string {
 	return constants.MayorQuotaPath(m.townRoot)
 }
 
+// write stamps state with provenance and writes it atomically.
+// Caller must hold the quota lock.
+func (m *Manager) write(state *config.QuotaState) error {
+	state.Provenance = buildinfo.Stamp(buildinfo.ConfigFingerprint(constants.MayorAccountsPath(m.townRoot)))
+	return util.EnsureDirAndWriteJSON(m.statePath(), state)
+}
+
 // lockPath returns the path to the flock file for quota state.
 func (m *Manager) lockPath() string {
 	return filepath.Join(m.townRoot, constants.DirMayor, constants.DirRuntime, "quota.lock")
@@ -75,6 +84,7 @@ func (m *Manager) Load() (*config.QuotaState, error) {
 	if state.Accounts == nil {
 		state.Accounts = make(map[string]config.AccountQuotaState)
 	}
+	buildinfo.NoteLoaded(m.statePath(), state.Provenance)
 	return &state, nil
 }
 
@@ -87,7 +97,7 @@ func (m *Manager) Save(state *config.QuotaState) error {
 	defer unlock()
 
 	state.Version = config.CurrentQuotaVersion
-	return util.EnsureDirAndWriteJSON(m.statePath(), state)
+	return m.write(state)
 }
 
 // WithLock acquires the quota file lock, runs fn, then releases the lock.
@@ -107,7 +117,7 @@ func (m *Manager) WithLock(fn func() error) error {
 // of WithLock will corrupt state under concurrent access.
 func (m *Manager) SaveUnlocked(state *config.QuotaState) error {
 	state.Version = config.CurrentQuotaVersion
-	return util.EnsureDirAndWriteJSON(m.statePath(), state)
+	return m.write(state)
 }
 
 // MarkLimited marks an account as rate-limited with an optional reset time.
@@ -131,7 +141,7 @@ func (m *Manager) MarkLimited(handle string, resetsAt string) error {
 		LastUsed:  state.Accounts[handle].LastUsed,
 	}
 
-	return util.EnsureDirAndWriteJSON(m.statePath(), state)
+	return m.write(state)
 }
 
 // MarkAvailable marks an account as available (not rate-limited).
@@ -153,7 +163,7 @@ func (m *Manager) MarkAvailable(handle string) error {
 		LastUsed: existing.LastUsed,
 	}
 
-	return util.EnsureDirAndWriteJSON(m.statePath(), state)
+	return m.write(state)
 }
 
 // AvailableAccounts returns account handles that are not rate-limited,
@@ -326,3 +336,122 @@ func ParseResetTime(resetsAt string, reference time.Time) (time.Time, error) {
 
 	return resetTime, nil
 }
+
+// resetTimeAbbrevPattern matches a clock time with a zone abbreviation,
+// e.g. "3:00 AM PST" or "7pm ET".
+var resetTimeAbbrevPattern = regexp.MustCompile(`(?i)^(\d{1,2})(?::(\d{2}))?\s*(am|pm)\s+([a-z]{2,4})\b`)
+
+// resetZoneAbbrevs maps the zone abbreviations Claude Code prints to a
+// location. Explicit standard/daylight abbreviations are fixed offsets;
+// generic ones ("PT") follow the zone's DST rules.
+var resetZoneAbbrevs = map[string]*time.Location{
+	"UTC": time.UTC,
+	"GMT": time.UTC,
+	"PST": time.FixedZone("PST", -8*3600),
+	"PDT": time.FixedZone("PDT", -7*3600),
+	"MST": time.FixedZone("MST", -7*3600),
+	"MDT": time.FixedZone("MDT", -6*3600),
+	"CST": time.FixedZone("CST", -6*3600),
+	"CDT": time.FixedZone("CDT", -5*3600),
+	"EST": time.FixedZone("EST", -5*3600),
+	"EDT": time.FixedZone("EDT", -4*3600),
+}
+
+// resetZoneGeneric maps generic US zone abbreviations to IANA names.
+var resetZoneGeneric = map[string]string{
+	"PT": "America/Los_Angeles",
+	"MT": "America/Denver",
+	"CT": "America/Chicago",
+	"ET": "America/New_York",
+}
+
+// ParseResetTimeAbsolute converts a reset time as printed by Claude Code
+// into the next matching instant. Supported formats:
+//
+//	"2026-02-28T19:00:00Z"         → that instant (RFC 3339 / ISO 8601)
+//	"7pm (America/Los_Angeles)"    → next 7pm in that timezone
+//	"3:00 AM PST", "7pm ET"        → next 3am in that zone
+//	"7pm"                          → next 7pm in local time
+//
+// Clock-only formats resolve to today if that is still in the future,
+// otherwise tomorrow; days are stepped on the wall clock, so DST changes
+// are honoured. Trailing text after the time is ignored.
+func ParseResetTimeAbsolute(text string) (time.Time, bool) {
+	return parseResetTimeAbsoluteAt(text, time.Now())
+}
+
+func parseResetTimeAbsoluteAt(text string, now time.Time) (time.Time, bool) {
+	text = strings.TrimSpace(text)
+	if fields := strings.Fields(text); len(fields) > 0 {
+		if t, err := time.Parse(time.RFC3339, fields[0]); err == nil {
+			return t, true
+		}
+	}
+
+	var reset time.Time
+	if m := resetTimeAbbrevPattern.FindStringSubmatch(text); m != nil {
+		zone := strings.ToUpper(m[4])
+		loc, ok := resetZoneAbbrevs[zone]
+		if !ok {
+			name, generic := resetZoneGeneric[zone]
+			if !generic {
+				return time.Time{}, false
+			}
+			var err error
+			if loc, err = time.LoadLocation(name); err != nil {
+				return time.Time{}, false
+			}
+		}
+		t, err := ParseResetTime(m[1]+":"+cmp.Or(m[2], "00")+m[3], now.In(loc))
+		if err != nil {
+			return time.Time{}, false
+		}
+		reset = t
+	} else {
+		t, err := ParseResetTime(text, now)
+		if err != nil {
+			return time.Time{}, false
+		}
+		reset = t
+	}
+
+	if !reset.After(now) {
+		reset = time.Date(reset.Year(), reset.Month(), reset.Day()+1,
+			reset.Hour(), reset.Minute(), 0, 0, reset.Location())
+	}
+	return reset, true
+}
+
+// quotaStateMaxBytes caps quota.json. A handful of accounts and swaps fit in
+// a few KiB; anything near this size is corruption or runaway growth.
+const quotaStateMaxBytes = 1 << 20
+
+func init() {
+	RegisterStateFile(StateFile{
+		Name: "quota-state",
+		Path: constants.MayorQuotaPath,
+		Validate: func(data []byte) error {
+			var state config.QuotaState
+			if err := json.Unmarshal(data, &state); err != nil {
+				return fmt.Errorf("parsing quota state: %w", err)
+			}
+			return nil
+		},
+		Prune: func(data []byte, now time.Time) ([]byte, int, error) {
+			var state config.QuotaState
+			if err := json.Unmarshal(data, &state); err != nil {
+				return nil, 0, err
+			}
+			cleared := clearExpiredAt(nil, &state, now)
+			if cleared == 0 {
+				return data, 0, nil
+			}
+			out, err := json.MarshalIndent(&state, "", "  ")
+			return out, cleared, err
+		},
+		MaxBytes: quotaStateMaxBytes,
+		Lock: func(townRoot string) (func(), error) {
+			return NewManager(townRoot).lock()
+		},
+	})
+}
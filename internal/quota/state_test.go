@@ -1,13 +1,16 @@
 package quota
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/steveyegge/gastown/internal/buildinfo"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 )
@@ -607,3 +610,123 @@ func TestClearExpired_NoResetsAt(t *testing.T) {
 		t.Errorf("expected no_reset to remain limited")
 	}
 }
+
+func TestQuotaState_ProvenanceRoundTrip(t *testing.T) {
+	townRoot := setupTestTown(t)
+	mgr := NewManager(townRoot)
+	var notices bytes.Buffer
+	t.Cleanup(buildinfo.SetNoticeOutput(&notices))
+
+	buildinfo.Set("0.1.0", "")
+	t.Cleanup(func() { buildinfo.Set("dev", "") })
+
+	if err := mgr.MarkLimited("work", "7pm"); err != nil {
+		t.Fatal(err)
+	}
+	state, err := mgr.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := state.Provenance
+	if p == nil || p.GTVersion != "0.1.0" || p.ConfigFingerprint == "" || p.WrittenAt.IsZero() {
+		t.Fatalf("provenance not round-tripped: %+v", p)
+	}
+	if notices.Len() != 0 {
+		t.Fatalf("same-version load should be silent, got %q", notices.String())
+	}
+
+	// A newer binary loading the file notes the mismatch exactly once.
+	buildinfo.Set("0.2.0", "")
+	for i := 0; i < 3; i++ {
+		if _, err := mgr.Load(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out := notices.String()
+	if n := strings.Count(out, "written by gt 0.1.0"); n != 1 {
+		t.Errorf("expected exactly one mismatch notice, got %d: %q", n, out)
+	}
+
+	// Saving restamps with the running version.
+	if err := mgr.Save(state); err != nil {
+		t.Fatal(err)
+	}
+	if state, _ = mgr.Load(); state.Provenance.GTVersion != "0.2.0" {
+		t.Errorf("restamped version = %q, want 0.2.0", state.Provenance.GTVersion)
+	}
+}
+
+func TestParseResetTimeAbsolute(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+	// 2026-02-10 15:00 PST (23:00 UTC)
+	now := time.Date(2026, 2, 10, 15, 0, 0, 0, la)
+
+	tests := []struct {
+		name string
+		text string
+		want time.Time
+	}{
+		{"iso", "2026-02-28T19:00:00Z", time.Date(2026, 2, 28, 19, 0, 0, 0, time.UTC)},
+		{"iana later today", "7pm (America/Los_Angeles)", time.Date(2026, 2, 10, 19, 0, 0, 0, la)},
+		{"iana tomorrow", "11am (America/Los_Angeles)", time.Date(2026, 2, 11, 11, 0, 0, 0, la)},
+		{"iana trailing text", "7pm (America/Los_Angeles) · /upgrade", time.Date(2026, 2, 10, 19, 0, 0, 0, la)},
+		{"abbrev", "3:00 AM PST", time.Date(2026, 2, 11, 11, 0, 0, 0, time.UTC)},
+		{"abbrev daylight", "5pm PDT", time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)},
+		{"generic abbrev", "8pm ET", time.Date(2026, 2, 11, 1, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseResetTimeAbsoluteAt(tt.text, now)
+			if !ok {
+				t.Fatalf("parseResetTimeAbsoluteAt(%q) failed", tt.text)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseResetTimeAbsoluteAt(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+
+	for _, bad := range []string{"", "soon", "7pm XYZ", "25:00"} {
+		if got, ok := parseResetTimeAbsoluteAt(bad, now); ok {
+			t.Errorf("parseResetTimeAbsoluteAt(%q) = %v, want failure", bad, got)
+		}
+	}
+}
+
+func TestParseResetTimeAbsolute_DST(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+
+	// The evening before spring-forward (2026-03-08): tomorrow's 7pm is 23
+	// wall-clock hours away but only 22 real hours.
+	now := time.Date(2026, 3, 7, 20, 0, 0, 0, la)
+	got, ok := parseResetTimeAbsoluteAt("7pm (America/Los_Angeles)", now)
+	if !ok {
+		t.Fatal("parse failed")
+	}
+	if want := time.Date(2026, 3, 9, 2, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("spring forward: got %v, want %v", got.UTC(), want)
+	}
+	if d := got.Sub(now); d != 22*time.Hour {
+		t.Errorf("spring forward: reset in %v, want 22h", d)
+	}
+
+	// The evening before fall-back (2026-11-01): 23 wall-clock hours are 24
+	// real hours.
+	now = time.Date(2026, 10, 31, 20, 0, 0, 0, la)
+	got, ok = parseResetTimeAbsoluteAt("7pm (America/Los_Angeles)", now)
+	if !ok {
+		t.Fatal("parse failed")
+	}
+	if want := time.Date(2026, 11, 2, 3, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("fall back: got %v, want %v", got.UTC(), want)
+	}
+	if d := got.Sub(now); d != 24*time.Hour {
+		t.Errorf("fall back: reset in %v, want 24h", d)
+	}
+}
@@ -0,0 +1,67 @@
+package quota
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrOpaqueToken is returned by ParseKeychainToken for tokens that carry no
+// readable expiry (neither a JSON credential nor a JWT).
+var ErrOpaqueToken = errors.New("token has no readable expiry")
+
+// KeychainToken is an OAuth token read from the keychain, with its expiry
+// when the token format exposes one.
+type KeychainToken struct {
+	Value         string
+	AccountHandle string     // account the token belongs to; set by the caller
+	ExpiresAt     *time.Time // nil when the expiry is unknown
+}
+
+// ParseKeychainToken extracts the expiry of a raw keychain token. Two
+// formats are understood: a JSON credential with an expires_at Unix time,
+// and a JWT, whose exp claim is read without verifying the signature.
+// Other tokens return ErrOpaqueToken along with a KeychainToken holding
+// just the Value.
+func ParseKeychainToken(raw string) (KeychainToken, error) {
+	tok := KeychainToken{Value: strings.TrimSpace(raw)}
+
+	var cred struct {
+		ExpiresAt int64 `json:"expires_at"`
+	}
+	if json.Unmarshal([]byte(tok.Value), &cred) == nil && cred.ExpiresAt > 0 {
+		exp := time.Unix(cred.ExpiresAt, 0)
+		tok.ExpiresAt = &exp
+		return tok, nil
+	}
+
+	parts := strings.Split(tok.Value, ".")
+	if len(parts) != 3 {
+		return tok, ErrOpaqueToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return tok, fmt.Errorf("decoding JWT payload: %w", err)
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return tok, fmt.Errorf("parsing JWT claims: %w", err)
+	}
+	if claims.Exp <= 0 {
+		return tok, ErrOpaqueToken
+	}
+	exp := time.Unix(claims.Exp, 0)
+	tok.ExpiresAt = &exp
+	return tok, nil
+}
+
+// IsExpired reports whether the token's expiry has passed. Tokens with an
+// unknown expiry are assumed valid.
+func (t KeychainToken) IsExpired() bool {
+	return t.ExpiresAt != nil && !time.Now().Before(*t.ExpiresAt)
+}
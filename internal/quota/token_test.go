@@ -0,0 +1,64 @@
+package quota
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// testJWT builds an unsigned JWT whose payload is claims.
+func testJWT(claims string) string {
+	enc := base64.RawURLEncoding.EncodeToString
+	return enc([]byte(`{"alg":"none"}`)) + "." + enc([]byte(claims)) + ".sig"
+}
+
+func TestParseKeychainToken(t *testing.T) {
+	past := time.Now().Add(-time.Hour).Unix()
+	future := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name        string
+		raw         string
+		wantErr     error // nil, ErrOpaqueToken, or errAny
+		wantExpiry  int64 // 0 = nil ExpiresAt
+		wantExpired bool
+	}{
+		{"expired JWT", testJWT(fmt.Sprintf(`{"exp":%d}`, past)), nil, past, true},
+		{"valid JWT", testJWT(fmt.Sprintf(`{"exp":%d,"sub":"acct"}`, future)), nil, future, false},
+		{"JSON credential", fmt.Sprintf(`{"access_token":"x","expires_at":%d}`, past), nil, past, true},
+		{"JWT without exp", testJWT(`{"sub":"acct"}`), ErrOpaqueToken, 0, false},
+		{"opaque token", "sk-ant-oat01-abcdef", ErrOpaqueToken, 0, false},
+		{"bad JWT payload", "aaa.!!!.ccc", errAny, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tok, err := ParseKeychainToken(tt.raw)
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Fatalf("err = %v, want nil", err)
+			case tt.wantErr == errAny && err == nil:
+				t.Fatal("err = nil, want an error")
+			case tt.wantErr == ErrOpaqueToken && !errors.Is(err, ErrOpaqueToken):
+				t.Fatalf("err = %v, want ErrOpaqueToken", err)
+			}
+			if tok.Value != tt.raw {
+				t.Errorf("Value = %q, want %q", tok.Value, tt.raw)
+			}
+			if tt.wantExpiry == 0 {
+				if tok.ExpiresAt != nil {
+					t.Errorf("ExpiresAt = %v, want nil", tok.ExpiresAt)
+				}
+			} else if tok.ExpiresAt == nil || tok.ExpiresAt.Unix() != tt.wantExpiry {
+				t.Errorf("ExpiresAt = %v, want unix %d", tok.ExpiresAt, tt.wantExpiry)
+			}
+			if got := tok.IsExpired(); got != tt.wantExpired {
+				t.Errorf("IsExpired() = %v, want %v", got, tt.wantExpired)
+			}
+		})
+	}
+}
+
+// errAny marks test cases that expect some error other than ErrOpaqueToken.
+var errAny = errors.New("any error")
@@ -2,6 +2,7 @@
 package session
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -9,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -16,24 +18,49 @@ import (
 	"regexp"
 
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/util"
 )
 
 // PrefixRegistry maps beads prefixes to rig names and vice versa.
 // Used to resolve session names that use rig-specific prefixes.
+//
+// A PrefixRegistry is safe for concurrent use: Register takes the write
+// lock and every lookup takes the read lock. The package-level default
+// registry pointer is guarded separately, so SetDefaultRegistry can swap
+// it while the daemon or scanner is reading the previous one.
 type PrefixRegistry struct {
 	mu          sync.RWMutex
 	prefixToRig map[string]string // "gt" → "gastown"
 	rigToPrefix map[string]string // "gastown" → "gt"
+	builtin     []string          // always-known session prefixes, e.g. "hq"
 }
 
-// NewPrefixRegistry creates an empty prefix registry.
+// NewPrefixRegistry creates a prefix registry with no rig mappings. The
+// town-level "hq" prefix is built in, so hq- sessions are always known.
 func NewPrefixRegistry() *PrefixRegistry {
-	return &PrefixRegistry{
+	r := &PrefixRegistry{
 		prefixToRig: make(map[string]string),
 		rigToPrefix: make(map[string]string),
 	}
+	return r.WithBuiltinPrefixes(strings.TrimSuffix(HQPrefix, "-"))
+}
+
+// WithBuiltinPrefixes marks prefixes as built in and returns r. Built-in
+// prefixes always identify Gas Town sessions (see MatchSession) regardless
+// of what is registered, and are not rig mappings: they are not listed by
+// ListPrefixes or saved by SavePrefixRegistry.
+func (r *PrefixRegistry) WithBuiltinPrefixes(prefixes ...string) *PrefixRegistry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range prefixes {
+		if !slices.Contains(r.builtin, p) {
+			r.builtin = append(r.builtin, p)
+		}
+	}
+	return r
 }
 
 // Register adds a prefix↔rig mapping.
@@ -92,6 +119,34 @@ func (r *PrefixRegistry) Prefixes() []string {
 	return prefixes
 }
 
+// PrefixEntry is one registered prefix↔rig mapping.
+type PrefixEntry struct {
+	Prefix string `json:"prefix"`
+	Rig    string `json:"rig"`
+}
+
+// ListPrefixes returns all registered mappings sorted alphabetically by prefix.
+func (r *PrefixRegistry) ListPrefixes() []PrefixEntry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entries := make([]PrefixEntry, 0, len(r.prefixToRig))
+	for prefix, rig := range r.prefixToRig {
+		entries = append(entries, PrefixEntry{Prefix: prefix, Rig: rig})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Prefix < entries[j].Prefix
+	})
+	return entries
+}
+
+// Has reports whether prefix is registered.
+func (r *PrefixRegistry) Has(prefix string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.prefixToRig[prefix]
+	return ok
+}
+
 // defaultRegistry is the package-level registry used by convenience functions.
 // Access is protected by defaultRegistryMu for concurrent test safety.
 var (
@@ -99,7 +154,8 @@ var (
 	defaultRegistryMu sync.RWMutex
 )
 
-// DefaultRegistry returns the package-level prefix registry.
+// DefaultRegistry returns the package-level prefix registry. Use its
+// ListPrefixes method to inspect the current registrations.
 func DefaultRegistry() *PrefixRegistry {
 	defaultRegistryMu.RLock()
 	defer defaultRegistryMu.RUnlock()
@@ -266,6 +322,62 @@ func BuildPrefixRegistryFromFile(path string) (*PrefixRegistry, error) {
 	return r, nil
 }
 
+// PrefixRegistryPath returns the runtime prefix file loaded at daemon startup.
+func PrefixRegistryPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "prefixes.jsonl")
+}
+
+// LoadPrefixRegistry reads a JSONL file with one {"prefix","rig"} entry per
+// line and returns a registry holding those entries. Blank lines are
+// skipped. A missing file yields an empty registry; a prefix listed twice
+// is an error.
+func LoadPrefixRegistry(path string) (*PrefixRegistry, error) {
+	r := NewPrefixRegistry()
+
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is a gt runtime file
+	if err != nil {
+		if os.IsNotExist(err) {
+			return r, nil
+		}
+		return nil, err
+	}
+
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var entry PrefixEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, i+1, err)
+		}
+		if entry.Prefix == "" || entry.Rig == "" {
+			return nil, fmt.Errorf("%s:%d: prefix and rig are required", path, i+1)
+		}
+		if r.Has(entry.Prefix) {
+			return nil, fmt.Errorf("%s:%d: duplicate prefix %q", path, i+1, entry.Prefix)
+		}
+		r.Register(entry.Prefix, entry.Rig)
+	}
+	return r, nil
+}
+
+// SavePrefixRegistry writes r's entries to path as JSONL, sorted by prefix,
+// in the format read by LoadPrefixRegistry.
+func SavePrefixRegistry(r *PrefixRegistry, path string) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, entry := range r.ListPrefixes() {
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return util.AtomicWriteFile(path, buf.Bytes(), 0644)
+}
+
 // LegacyPrefixes are prefixes accepted as valid even when the registry is empty.
 // gt = default rig, bd = beads, hq = town-level HQ services, gthq = gastown HQ.
 var LegacyPrefixes = []string{"gt", "bd", "hq", "gthq"}
@@ -298,12 +410,39 @@ func (r *PrefixRegistry) HasPrefix(sess string) bool {
 }
 
 // IsKnownSession returns true if the session name belongs to Gas Town.
-// Checks for HQ prefix and registered rig prefixes from the default registry.
+// Checks built-in prefixes (hq) and registered rig prefixes from the
+// default registry.
 func IsKnownSession(sess string) bool {
-	if strings.HasPrefix(sess, HQPrefix) {
-		return true
+	_, ok := MatchSession(sess)
+	return ok
+}
+
+// MatchSession returns the Gas Town prefix a session name starts with,
+// including the trailing dash (e.g. "hq-" or "gt-"), and true. Built-in
+// prefixes are checked first, then registered rig prefixes longest first.
+// Returns "", false for sessions that don't belong to Gas Town.
+func MatchSession(sess string) (prefix string, ok bool) {
+	r := DefaultRegistry()
+	if p, matched := r.matchBuiltin(sess); matched {
+		return p + "-", true
+	}
+	if p, _, matched := r.matchPrefix(sess); matched {
+		return p + "-", true
+	}
+	return "", false
+}
+
+// matchBuiltin returns the built-in prefix session starts with (followed by
+// a dash), if any.
+func (r *PrefixRegistry) matchBuiltin(session string) (prefix string, matched bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, p := range r.builtin {
+		if strings.HasPrefix(session, p+"-") {
+			return p, true
+		}
 	}
-	return DefaultRegistry().HasPrefix(sess)
+	return "", false
 }
 
 // matchPrefix finds the prefix in a session name suffix using the registry.
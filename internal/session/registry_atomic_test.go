@@ -47,6 +47,64 @@ func TestIsKnownSession_UsesDefaultRegistryAndHQPrefix(t *testing.T) {
 	}
 }
 
+func TestNewPrefixRegistry_HQBuiltin(t *testing.T) {
+	old := DefaultRegistry()
+	defer SetDefaultRegistry(old)
+
+	// A fresh registry, without Register("hq", ...), still knows hq- sessions.
+	r := NewPrefixRegistry()
+	SetDefaultRegistry(r)
+	if !IsKnownSession("hq-mayor") {
+		t.Error("expected hq-mayor to be known by a fresh registry")
+	}
+	if IsKnownSession("hqx-mayor") {
+		t.Error("hqx-mayor should not match the hq builtin")
+	}
+	if got := r.ListPrefixes(); len(got) != 0 {
+		t.Errorf("ListPrefixes() = %v, builtins should not be listed", got)
+	}
+
+	// Extra builtins behave the same way.
+	r.WithBuiltinPrefixes("ops")
+	if prefix, ok := MatchSession("ops-runner"); !ok || prefix != "ops-" {
+		t.Errorf("MatchSession(ops-runner) = %q, %v; want ops-, true", prefix, ok)
+	}
+	if r.Has("ops") {
+		t.Error("builtin prefix reported as a registered rig prefix")
+	}
+}
+
+func TestMatchSession(t *testing.T) {
+	old := DefaultRegistry()
+	defer SetDefaultRegistry(old)
+
+	r := NewPrefixRegistry()
+	r.Register("gt", "gastown")
+	r.Register("gt-x", "gtx")
+	r.Register("bd", "beads")
+	SetDefaultRegistry(r)
+
+	tests := []struct {
+		session    string
+		wantPrefix string
+		wantOK     bool
+	}{
+		{"hq-mayor", "hq-", true},
+		{"gt-witness", "gt-", true},
+		{"gt-crew-bear", "gt-", true},
+		{"gt-x-refinery", "gt-x-", true}, // longest prefix wins
+		{"bd-nux", "bd-", true},
+		{"zz-worker", "", false},
+		{"gastown", "", false},
+	}
+	for _, tt := range tests {
+		prefix, ok := MatchSession(tt.session)
+		if prefix != tt.wantPrefix || ok != tt.wantOK {
+			t.Errorf("MatchSession(%q) = %q, %v; want %q, %v", tt.session, prefix, ok, tt.wantPrefix, tt.wantOK)
+		}
+	}
+}
+
 func TestInitRegistryLoadsAgentRegistry(t *testing.T) {
 	// Regression test: InitRegistry must load settings/agents.json so that
 	// config.GetProcessNames respects user-configured process_names overrides.
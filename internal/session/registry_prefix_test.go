@@ -0,0 +1,132 @@
+package session
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestPrefixRegistryListPrefixes(t *testing.T) {
+	r := NewPrefixRegistry()
+	r.Register("wy", "wyvern")
+	r.Register("gt", "gastown")
+	r.Register("bd", "beads")
+	r.Register("sky", "skyline")
+	r.Register("ab", "abacus")
+
+	want := []PrefixEntry{
+		{Prefix: "ab", Rig: "abacus"},
+		{Prefix: "bd", Rig: "beads"},
+		{Prefix: "gt", Rig: "gastown"},
+		{Prefix: "sky", Rig: "skyline"},
+		{Prefix: "wy", Rig: "wyvern"},
+	}
+	got := r.ListPrefixes()
+	if len(got) != len(want) {
+		t.Fatalf("ListPrefixes() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ListPrefixes()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if !r.Has("sky") {
+		t.Error("Has(sky) = false, want true")
+	}
+	if r.Has("zz") {
+		t.Error("Has(zz) = true, want false")
+	}
+}
+
+func TestPrefixRegistryListPrefixes_Empty(t *testing.T) {
+	if got := NewPrefixRegistry().ListPrefixes(); len(got) != 0 {
+		t.Errorf("ListPrefixes() = %v, want empty", got)
+	}
+}
+
+func TestSaveLoadPrefixRegistryRoundTrip(t *testing.T) {
+	r := NewPrefixRegistry()
+	r.Register("gt", "gastown")
+	r.Register("bd", "beads")
+	r.Register("ti", "tinker")
+
+	path := PrefixRegistryPath(t.TempDir())
+	if err := SavePrefixRegistry(r, path); err != nil {
+		t.Fatalf("SavePrefixRegistry: %v", err)
+	}
+
+	loaded, err := LoadPrefixRegistry(path)
+	if err != nil {
+		t.Fatalf("LoadPrefixRegistry: %v", err)
+	}
+	want, got := r.ListPrefixes(), loaded.ListPrefixes()
+	if len(got) != len(want) {
+		t.Fatalf("loaded %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("entry %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestLoadPrefixRegistry_DuplicatePrefix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prefixes.jsonl")
+	data := `{"prefix":"gt","rig":"gastown"}
+{"prefix":"gt","rig":"other"}
+`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadPrefixRegistry(path); err == nil || !strings.Contains(err.Error(), "duplicate prefix") {
+		t.Fatalf("LoadPrefixRegistry error = %v, want duplicate prefix", err)
+	}
+}
+
+func TestLoadPrefixRegistry_Missing(t *testing.T) {
+	r, err := LoadPrefixRegistry(filepath.Join(t.TempDir(), "prefixes.jsonl"))
+	if err != nil {
+		t.Fatalf("LoadPrefixRegistry: %v", err)
+	}
+	if got := r.ListPrefixes(); len(got) != 0 {
+		t.Errorf("ListPrefixes() = %v, want empty", got)
+	}
+}
+
+func TestPrefixRegistry_ConcurrentAccess(t *testing.T) {
+	r := NewPrefixRegistry()
+	r.Register("gt", "gastown")
+	old := DefaultRegistry()
+	SetDefaultRegistry(r)
+	t.Cleanup(func() { SetDefaultRegistry(old) })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			switch i % 4 {
+			case 0:
+				r.Register(fmt.Sprintf("p%d", i), fmt.Sprintf("rig%d", i))
+			case 1:
+				if !IsKnownSession("gt-crew-max") {
+					t.Error("gt-crew-max not recognised")
+				}
+			case 2:
+				_ = r.ListPrefixes()
+			case 3:
+				SetDefaultRegistry(r)
+				_, _ = MatchSession("hq-mayor")
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := len(r.ListPrefixes()); got != 26 {
+		t.Errorf("ListPrefixes() has %d entries, want 26", got)
+	}
+}
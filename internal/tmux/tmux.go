@@ -2638,11 +2638,13 @@ func (t *Tmux) WaitForShellReady(session string, timeout time.Duration) error {
 // (U+00A0) to regular spaces before matching, because Claude Code uses
 // NBSP after its ❯ prompt character while the default ReadyPromptPrefix
 // uses a regular space. See https://github.com/steveyegge/gastown/issues/1387.
+// ANSI escape codes are stripped first, since some agents (e.g. Aider)
+// color their prompt.
 func matchesPromptPrefix(line, readyPromptPrefix string) bool {
 	if readyPromptPrefix == "" {
 		return false
 	}
-	trimmed := strings.TrimSpace(line)
+	trimmed := strings.TrimSpace(stripANSI(line))
 	// Normalize NBSP (U+00A0) → regular space so that prompt matching
 	// works regardless of which whitespace character the agent uses.
 	trimmed = strings.ReplaceAll(trimmed, "\u00a0", " ")
@@ -2651,6 +2653,18 @@ func matchesPromptPrefix(line, readyPromptPrefix string) bool {
 	return strings.HasPrefix(trimmed, normalizedPrefix) || (prefix != "" && trimmed == prefix)
 }
 
+// ansiEscapeRe matches ANSI CSI sequences (colors, cursor movement) and OSC
+// sequences (titles, hyperlinks).
+var ansiEscapeRe = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(\x07|\x1b\\)`)
+
+// stripANSI removes ANSI escape sequences from s.
+func stripANSI(s string) string {
+	if !strings.Contains(s, "\x1b") {
+		return s
+	}
+	return ansiEscapeRe.ReplaceAllString(s, "")
+}
+
 func hasBusyIndicator(line string) bool {
 	trimmed := strings.TrimSpace(line)
 	if trimmed == "" {
@@ -3279,7 +3293,7 @@ var safePrefixRe = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{0,19}$`)
 // Example output: "^(bd|db|fa|gl|gt|hq|la|lc)-"
 func sessionPrefixPattern() string {
 	seen := map[string]bool{"hq": true, "gt": true} // always include HQ + gastown fallback
-	townRoot := os.Getenv("GT_ROOT")
+	townRoot, _, _ := config.ResolveRootEnv()
 	if townRoot != "" {
 		for _, p := range config.AllRigPrefixes(townRoot) {
 			if safePrefixRe.MatchString(p) {
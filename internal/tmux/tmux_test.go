@@ -1771,6 +1771,13 @@ func TestMatchesPromptPrefix(t *testing.T) {
 
 		// Bare prompt character without any space
 		{"bare prompt no space", "❯", regularPrefix, true},
+
+		// ANSI-colored prompts (Aider colors its "> " prompt)
+		{"aider plain prompt", "> ", "> ", true},
+		{"aider colored prompt", "\x1b[1;32m> \x1b[0m", "> ", true},
+		{"aider colored prompt with input", "\x1b[32m>\x1b[0m \x1b[1mfix the bug", "> ", true},
+		{"aider colored non-prompt", "\x1b[33mAdded main.go to the chat\x1b[0m", "> ", false},
+		{"colored claude prompt", "\x1b[2m❯\x1b[22m" + nbsp, regularPrefix, true},
 	}
 
 	for _, tt := range tests {
@@ -1784,6 +1791,24 @@ func TestMatchesPromptPrefix(t *testing.T) {
 	}
 }
 
+func TestStripANSI(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in, want string
+	}{
+		{"plain", "plain"},
+		{"\x1b[1;32m> \x1b[0m", "> "},
+		{"\x1b[?25lhidden cursor\x1b[?25h", "hidden cursor"},
+		{"\x1b]0;title\x07text", "text"},
+		{"\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", "link"},
+	}
+	for _, tt := range tests {
+		if got := stripANSI(tt.in); got != tt.want {
+			t.Errorf("stripANSI(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
 func TestWaitForIdle_Timeout(t *testing.T) {
 	if os.Getenv("TMUX") == "" {
 		t.Skip("not inside tmux")
@@ -7,6 +7,8 @@ import (
 	"os/exec"
 	"runtime/debug"
 	"strings"
+
+	"github.com/steveyegge/gastown/internal/config"
 )
 
 // These variables are set at build time via ldflags in cmd package.
@@ -148,7 +150,7 @@ func CheckStaleBinary(repoDir string) *StaleBinaryInfo {
 // so we prefer the gastown repo over CWD-based git toplevel detection.
 func GetRepoRoot() (string, error) {
 	// Check if GT_ROOT environment variable is set (agents always have this)
-	if gtRoot := os.Getenv("GT_ROOT"); gtRoot != "" {
+	if gtRoot, _, _ := config.ResolveRootEnv(); gtRoot != "" {
 		candidates := []string{
 			gtRoot + "/gastown",
 			gtRoot + "/gastown/mayor/rig",
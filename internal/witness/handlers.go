@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -20,6 +21,7 @@ import (
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/mayor"
 	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/quota"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/tmux"
@@ -990,12 +992,39 @@ const (
 	ZombieSessionDeadActive ZombieClassification = "session-dead-active"
 	// ZombieAgentSelfReportedStuck: agent self-reported stuck via heartbeat v2 (gt-3vr5).
 	ZombieAgentSelfReportedStuck ZombieClassification = "agent-self-reported-stuck"
+	// ZombieRateLimited: session is rate-limited and waiting for its quota to
+	// reset. Not restarted — a fresh session loses context and is still limited.
+	ZombieRateLimited ZombieClassification = "rate-limited"
+	// ZombiePending: session appears dead but the heartbeat is younger than
+	// the detector's grace period. Reported only; no action is taken.
+	ZombiePending ZombieClassification = "pending"
 )
 
+// ValidZombieClassifications returns every known ZombieClassification.
+// TestValidZombieClassifications_MatchesConstants fails if a constant is
+// added without being listed here.
+func ValidZombieClassifications() []ZombieClassification {
+	return []ZombieClassification{
+		ZombieStuckInDone,
+		ZombieAgentDeadInSession,
+		ZombieBeadClosedStillRunning,
+		ZombieDoneIntentDead,
+		ZombieIdleDirtySandbox,
+		ZombieSessionDeadActive,
+		ZombieAgentSelfReportedStuck,
+		ZombieRateLimited,
+		ZombiePending,
+	}
+}
+
+// IsValid reports whether c is one of ValidZombieClassifications.
+func (c ZombieClassification) IsValid() bool {
+	return slices.Contains(ValidZombieClassifications(), c)
+}
+
 // ImpliesActiveWork returns true if this classification indicates the polecat
-// had evidence of recent work (active state or hooked bead). Used by
-// receiptVerdictForZombie to derive patrol verdicts from the typed classification
-// rather than a separately-computed boolean. See gt-tsut.
+// had evidence of recent work (active state or hooked bead). Patrol receipts
+// give these a stale verdict (see receiptVerdictForZombie and gt-tsut).
 func (c ZombieClassification) ImpliesActiveWork() bool {
 	switch c {
 	case ZombieStuckInDone, ZombieAgentDeadInSession, ZombieBeadClosedStillRunning,
@@ -1006,16 +1035,45 @@ func (c ZombieClassification) ImpliesActiveWork() bool {
 	}
 }
 
+// Zombie severities for patrol triage, from SeverityForClassification.
+const (
+	SeverityLow      = 1
+	SeverityMedium   = 2
+	SeverityHigh     = 3
+	SeverityCritical = 4
+)
+
+// SeverityForClassification ranks a zombie classification for triage.
+// Critical: live work with a dead session. High: the agent is dead or stuck
+// mid-work. Medium: leftover state that wastes resources. Low: waiting on
+// something external (quota reset). Unknown classifications are medium.
+func SeverityForClassification(c ZombieClassification) int {
+	switch c {
+	case ZombieSessionDeadActive:
+		return SeverityCritical
+	case ZombieAgentDeadInSession, ZombieDoneIntentDead, ZombieAgentSelfReportedStuck:
+		return SeverityHigh
+	case ZombieStuckInDone, ZombieBeadClosedStillRunning, ZombieIdleDirtySandbox:
+		return SeverityMedium
+	case ZombieRateLimited, ZombiePending:
+		return SeverityLow
+	default:
+		return SeverityMedium
+	}
+}
+
 // ZombieResult describes a detected zombie polecat and the action taken.
 type ZombieResult struct {
 	PolecatName    string
 	AgentState     string               // Real agent state from DB (e.g., "working", "idle")
 	Classification ZombieClassification // Why this polecat is classified as a zombie (gt-tsut)
+	Severity       int                  // SeverityForClassification(Classification)
 	HookBead       string
 	CleanupStatus  string // Observed cleanup_status (ZFC: report data, agent decides policy)
 	WasActive      bool   // true if evidence of recent work (active state or hooked bead)
-	Action         string // "restarted", "escalated", "cleanup-wisp-created", "auto-nuked" (explicit nuke only)
+	Action         string // "restarted", "escalated", "cleanup-wisp-created", "waiting-for-reset", "auto-nuked" (explicit nuke only)
 	BeadRecovered  bool   // true if hooked bead was reset to open for re-dispatch
+	ResetsAt       string // Quota reset time for ZombieRateLimited, as shown by the provider
 	Error          error
 }
 
@@ -1027,6 +1085,43 @@ type DetectZombiePolecatsResult struct {
 	Errors         []error               // Transient errors that prevented checking some polecats
 }
 
+// RateLimited returns how many polecats were classified ZombieRateLimited.
+// These are waiting for a quota reset rather than dead, so summaries report
+// them separately from real zombies.
+func (r *DetectZombiePolecatsResult) RateLimited() int {
+	if r == nil {
+		return 0
+	}
+	n := 0
+	for _, z := range r.Zombies {
+		if z.Classification == ZombieRateLimited {
+			n++
+		}
+	}
+	return n
+}
+
+// CriticalCount returns how many zombies have at least SeverityHigh.
+func (r *DetectZombiePolecatsResult) CriticalCount() int {
+	if r == nil {
+		return 0
+	}
+	n := 0
+	for _, z := range r.Zombies {
+		if z.Severity >= SeverityHigh {
+			n++
+		}
+	}
+	return n
+}
+
+// QuotaStateProvider reports the latest quota scan for a tmux session.
+// *quota.ScanCache implements it. ok is false when the provider has no data
+// for the session, in which case zombie detection proceeds as usual.
+type QuotaStateProvider interface {
+	Lookup(session string) (result quota.ScanResult, ok bool)
+}
+
 // DetectZombiePolecats cross-references polecat agent state with tmux session
 // existence and agent process liveness to find zombie polecats. Two zombie classes:
 //   - Session-dead: tmux session is dead but agent bead still shows agent_state=
@@ -1057,6 +1152,47 @@ type DetectZombiePolecatsResult struct {
 //   - If git state is dirty (unpushed/uncommitted work): report cleanup_status,
 //     create cleanup wisp (witness agent decides escalation policy, gt-5rne)
 func DetectZombiePolecats(bd *BdCli, workDir, rigName string, router *mail.Router) *DetectZombiePolecatsResult {
+	return DetectZombiePolecatsWithQuota(bd, workDir, rigName, router, nil)
+}
+
+// DetectZombiePolecatsWithQuota is DetectZombiePolecats with quota awareness.
+// A rate-limited agent produces no output and keeps its hook, which looks
+// like a zombie. Live sessions that quotaState reports as rate-limited are
+// classified ZombieRateLimited and left alone until the next patrol. A nil
+// quotaState gives the plain DetectZombiePolecats behavior.
+func DetectZombiePolecatsWithQuota(bd *BdCli, workDir, rigName string, router *mail.Router, quotaState QuotaStateProvider) *DetectZombiePolecatsResult {
+	return NewDetectorWithConfig(ZombieDetectorConfig{}).Detect(bd, workDir, rigName, router, quotaState)
+}
+
+// ZombieDetectorConfig tunes zombie detection.
+type ZombieDetectorConfig struct {
+	// GracePeriod is how long after its last heartbeat a polecat whose session
+	// appears dead is classified ZombiePending rather than a zombie, so a
+	// session caught mid-restart is not acted on. Zero uses the witness
+	// zombie_grace_period setting, which is disabled by default.
+	GracePeriod time.Duration
+}
+
+// ZombieDetector runs zombie detection with a fixed configuration.
+type ZombieDetector struct {
+	cfg ZombieDetectorConfig
+}
+
+// NewDetectorWithConfig returns a ZombieDetector using cfg.
+func NewDetectorWithConfig(cfg ZombieDetectorConfig) *ZombieDetector {
+	return &ZombieDetector{cfg: cfg}
+}
+
+// gracePeriod returns the configured grace period, falling back to witCfg.
+func (d *ZombieDetector) gracePeriod(witCfg *config.WitnessThresholds) time.Duration {
+	if d.cfg.GracePeriod > 0 {
+		return d.cfg.GracePeriod
+	}
+	return witCfg.ZombieGracePeriodD()
+}
+
+// Detect is DetectZombiePolecatsWithQuota using the detector's configuration.
+func (d *ZombieDetector) Detect(bd *BdCli, workDir, rigName string, router *mail.Router, quotaState QuotaStateProvider) *DetectZombiePolecatsResult {
 	result := &DetectZombiePolecatsResult{}
 
 	townRoot, err := workspace.Find(workDir)
@@ -1067,6 +1203,7 @@ func DetectZombiePolecats(bd *BdCli, workDir, rigName string, router *mail.Route
 
 	// Load witness thresholds from config (fallback to compiled-in defaults).
 	witCfg := config.LoadOperationalConfig(townRoot).GetWitnessConfig()
+	grace := d.gracePeriod(witCfg)
 
 	polecatsDir := filepath.Join(townRoot, rigName, "polecats")
 	entries, err := os.ReadDir(polecatsDir)
@@ -1135,17 +1272,31 @@ func DetectZombiePolecats(bd *BdCli, workDir, rigName string, router *mail.Route
 				continue
 			}
 
+			if zombie, found := classifyRateLimited(quotaState, polecatName, sessionName, snap); found {
+				result.Zombies = append(result.Zombies, zombie)
+				continue
+			}
+
 			if zombie, found := detectZombieLiveSession(bd, workDir, townRoot, rigName, polecatName, sessionName, t, doneIntent, witCfg, snap); found {
 				result.Zombies = append(result.Zombies, zombie)
 			}
 			continue // Either handled or not a zombie
 		}
 
+		if zombie, found := classifyPending(townRoot, polecatName, sessionName, grace, snap); found {
+			result.Zombies = append(result.Zombies, zombie)
+			continue
+		}
+
 		if zombie, found := detectZombieDeadSession(bd, workDir, townRoot, rigName, polecatName, sessionName, t, doneIntent, detectedAt, witCfg, snap); found {
 			result.Zombies = append(result.Zombies, zombie)
 		}
 	}
 
+	for i := range result.Zombies {
+		result.Zombies[i].Severity = SeverityForClassification(result.Zombies[i].Classification)
+	}
+
 	// Mountain-Eater Layer 1 (gt-cfq): Track polecat failures for convoy-tracked issues.
 	// For each zombie with an active hook_bead (polecat failed without completing work),
 	// check if the issue belongs to a convoy and track the failure.
@@ -1403,6 +1554,49 @@ func detectZombieDeadSession(bd *BdCli, workDir, townRoot, rigName, polecatName,
 	return zombie, true
 }
 
+// classifyRateLimited reports a live polecat session that quotaState shows as
+// rate-limited. The result carries the reset time and takes no action.
+func classifyRateLimited(quotaState QuotaStateProvider, polecatName, sessionName string, snap *agentBeadSnapshot) (ZombieResult, bool) {
+	if quotaState == nil {
+		return ZombieResult{}, false
+	}
+	scan, ok := quotaState.Lookup(sessionName)
+	if !ok || !scan.RateLimited {
+		return ZombieResult{}, false
+	}
+	zombie := ZombieResult{
+		PolecatName:    polecatName,
+		Classification: ZombieRateLimited,
+		ResetsAt:       scan.ResetsAt,
+		Action:         "waiting-for-reset",
+	}
+	if snap != nil {
+		zombie.AgentState, zombie.HookBead = snap.AgentState, snap.HookBead
+	}
+	return zombie, true
+}
+
+// classifyPending reports a polecat whose session appears dead but whose
+// heartbeat is younger than grace. Only polecats with active work qualify;
+// an idle polecat without a session is normal. A zero grace disables it.
+func classifyPending(townRoot, polecatName, sessionName string, grace time.Duration, snap *agentBeadSnapshot) (ZombieResult, bool) {
+	if grace <= 0 || snap == nil || !isZombieState(beads.AgentState(snap.AgentState), snap.HookBead) {
+		return ZombieResult{}, false
+	}
+	hb := polecat.ReadSessionHeartbeat(townRoot, sessionName)
+	if hb == nil || time.Since(hb.Timestamp) >= grace {
+		return ZombieResult{}, false
+	}
+	return ZombieResult{
+		PolecatName:    polecatName,
+		AgentState:     snap.AgentState,
+		Classification: ZombiePending,
+		HookBead:       snap.HookBead,
+		WasActive:      true,
+		Action:         "pending-grace-period",
+	}, true
+}
+
 // isZombieState returns true if the agent state or hook bead indicates a zombie.
 // Uses typed AgentState to leverage IsActive() metadata rather than hardcoded
 // string comparisons. See gt-tsut.
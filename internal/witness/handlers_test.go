@@ -3,6 +3,9 @@ package witness
 import (
 	"encoding/json"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -13,6 +16,7 @@ import (
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/quota"
 	"github.com/steveyegge/gastown/internal/tmux"
 )
 
@@ -1913,3 +1917,233 @@ func TestNotifyRefineryMergeReady_EmitsChannelEvent(t *testing.T) {
 		t.Errorf("payload.rig = %v, want dashboard", payload["rig"])
 	}
 }
+
+// fakeQuotaState is a QuotaStateProvider backed by a map of session scans.
+type fakeQuotaState map[string]quota.ScanResult
+
+func (f fakeQuotaState) Lookup(session string) (quota.ScanResult, bool) {
+	r, ok := f[session]
+	return r, ok
+}
+
+func TestClassifyRateLimited(t *testing.T) {
+	t.Parallel()
+	const sess = "gt-atlas"
+	snap := &agentBeadSnapshot{AgentState: "working", HookBead: "gt-123"}
+
+	// Patrol 1: the quota scan shows the session limited. No action is taken.
+	provider := fakeQuotaState{sess: {Session: sess, RateLimited: true, ResetsAt: "7pm (America/Los_Angeles)"}}
+	z, found := classifyRateLimited(provider, "atlas", sess, snap)
+	if !found {
+		t.Fatal("rate-limited session not classified")
+	}
+	if z.Classification != ZombieRateLimited || z.Action != "waiting-for-reset" {
+		t.Errorf("got classification=%q action=%q, want rate-limited/waiting-for-reset", z.Classification, z.Action)
+	}
+	if z.ResetsAt != "7pm (America/Los_Angeles)" || z.HookBead != "gt-123" || z.AgentState != "working" {
+		t.Errorf("evidence not carried: %+v", z)
+	}
+	if z.WasActive || z.Error != nil {
+		t.Errorf("rate-limited polecat should not be reported as active-work or errored: %+v", z)
+	}
+
+	// Patrol 2: the quota reset. Normal zombie classification resumes.
+	provider[sess] = quota.ScanResult{Session: sess}
+	if _, found := classifyRateLimited(provider, "atlas", sess, snap); found {
+		t.Error("recovered session still classified rate-limited")
+	}
+
+	// Provider unavailable, or without data for the session: unchanged behavior.
+	if _, found := classifyRateLimited(nil, "atlas", sess, snap); found {
+		t.Error("nil provider classified session")
+	}
+	if _, found := classifyRateLimited(fakeQuotaState{}, "atlas", sess, snap); found {
+		t.Error("provider without session data classified session")
+	}
+}
+
+func TestDetectZombiePolecatsResult_RateLimited(t *testing.T) {
+	t.Parallel()
+	var nilResult *DetectZombiePolecatsResult
+	if n := nilResult.RateLimited(); n != 0 {
+		t.Errorf("nil RateLimited() = %d, want 0", n)
+	}
+	result := &DetectZombiePolecatsResult{Zombies: []ZombieResult{
+		{PolecatName: "a", Classification: ZombieRateLimited},
+		{PolecatName: "b", Classification: ZombieSessionDeadActive},
+		{PolecatName: "c", Classification: ZombieRateLimited},
+	}}
+	if n := result.RateLimited(); n != 2 {
+		t.Errorf("RateLimited() = %d, want 2", n)
+	}
+}
+
+func TestDetectZombiePolecatsWithQuota_NoSessionsUnchanged(t *testing.T) {
+	t.Parallel()
+	// Quota data for sessions that aren't alive must not invent zombies.
+	tmpDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(tmpDir, "testrig", "polecats", "alpha"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	provider := fakeQuotaState{"gt-alpha": {RateLimited: true}}
+	result := DetectZombiePolecatsWithQuota(DefaultBdCli(), tmpDir, "testrig", nil, provider)
+	if result.Checked != 1 || len(result.Zombies) != 0 {
+		t.Errorf("Checked=%d Zombies=%d, want 1/0", result.Checked, len(result.Zombies))
+	}
+}
+
+func TestSeverityForClassification(t *testing.T) {
+	tests := []struct {
+		c    ZombieClassification
+		want int
+	}{
+		{ZombieSessionDeadActive, SeverityCritical},
+		{ZombieAgentDeadInSession, SeverityHigh},
+		{ZombieDoneIntentDead, SeverityHigh},
+		{ZombieAgentSelfReportedStuck, SeverityHigh},
+		{ZombieStuckInDone, SeverityMedium},
+		{ZombieBeadClosedStillRunning, SeverityMedium},
+		{ZombieIdleDirtySandbox, SeverityMedium},
+		{ZombieRateLimited, SeverityLow},
+		{ZombieClassification("unknown"), SeverityMedium},
+	}
+	for _, tt := range tests {
+		if got := SeverityForClassification(tt.c); got != tt.want {
+			t.Errorf("SeverityForClassification(%q) = %d, want %d", tt.c, got, tt.want)
+		}
+	}
+}
+
+func TestDetectZombiePolecatsResult_CriticalCount(t *testing.T) {
+	var nilResult *DetectZombiePolecatsResult
+	if got := nilResult.CriticalCount(); got != 0 {
+		t.Errorf("nil CriticalCount() = %d, want 0", got)
+	}
+
+	result := &DetectZombiePolecatsResult{Zombies: []ZombieResult{
+		{PolecatName: "a", Severity: SeverityCritical},
+		{PolecatName: "b", Severity: SeverityHigh},
+		{PolecatName: "c", Severity: SeverityMedium},
+		{PolecatName: "d", Severity: SeverityLow},
+	}}
+	if got := result.CriticalCount(); got != 2 {
+		t.Errorf("CriticalCount() = %d, want 2", got)
+	}
+}
+
+func writeTestHeartbeat(t *testing.T, townRoot, sessionName string, age time.Duration) {
+	t.Helper()
+	dir := filepath.Join(townRoot, ".runtime", "heartbeats")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	ts := time.Now().Add(-age).UTC().Format(time.RFC3339Nano)
+	data := []byte(`{"timestamp":"` + ts + `","state":"working"}`)
+	if err := os.WriteFile(filepath.Join(dir, sessionName+".json"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClassifyPending_WithinGracePeriod(t *testing.T) {
+	townRoot := t.TempDir()
+	writeTestHeartbeat(t, townRoot, "gt-nux", 30*time.Second)
+	snap := &agentBeadSnapshot{AgentState: "working", HookBead: "gt-abc"}
+
+	d := NewDetectorWithConfig(ZombieDetectorConfig{GracePeriod: 60 * time.Second})
+	zombie, found := classifyPending(townRoot, "nux", "gt-nux", d.gracePeriod(nil), snap)
+	if !found {
+		t.Fatal("expected pending classification for 30s-old heartbeat with 60s grace")
+	}
+	if zombie.Classification != ZombiePending {
+		t.Errorf("Classification = %q, want %q", zombie.Classification, ZombiePending)
+	}
+	if v := BuildPatrolReceipt("gastown", zombie).Verdict; v != PatrolVerdictPending {
+		t.Errorf("Verdict = %q, want %q", v, PatrolVerdictPending)
+	}
+}
+
+func TestClassifyPending_NotPending(t *testing.T) {
+	townRoot := t.TempDir()
+	writeTestHeartbeat(t, townRoot, "gt-old", 2*time.Minute)
+	writeTestHeartbeat(t, townRoot, "gt-idle", 10*time.Second)
+	working := &agentBeadSnapshot{AgentState: "working", HookBead: "gt-abc"}
+
+	tests := []struct {
+		name    string
+		session string
+		grace   time.Duration
+		snap    *agentBeadSnapshot
+	}{
+		{"heartbeat older than grace", "gt-old", time.Minute, working},
+		{"grace disabled", "gt-idle", 0, working},
+		{"no heartbeat", "gt-missing", time.Minute, working},
+		{"idle without work", "gt-idle", time.Minute, &agentBeadSnapshot{AgentState: "idle"}},
+		{"no snapshot", "gt-idle", time.Minute, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, found := classifyPending(townRoot, "nux", tt.session, tt.grace, tt.snap); found {
+				t.Error("expected no pending classification")
+			}
+		})
+	}
+}
+
+func TestZombieDetector_GracePeriodFallback(t *testing.T) {
+	d := NewDetectorWithConfig(ZombieDetectorConfig{})
+	if got := d.gracePeriod(nil); got != 0 {
+		t.Errorf("default gracePeriod = %v, want 0 (disabled)", got)
+	}
+	if got := d.gracePeriod(&config.WitnessThresholds{ZombieGracePeriod: "45s"}); got != 45*time.Second {
+		t.Errorf("gracePeriod from config = %v, want 45s", got)
+	}
+	d = NewDetectorWithConfig(ZombieDetectorConfig{GracePeriod: time.Minute})
+	if got := d.gracePeriod(&config.WitnessThresholds{ZombieGracePeriod: "45s"}); got != time.Minute {
+		t.Errorf("explicit gracePeriod = %v, want 1m", got)
+	}
+}
+
+func TestZombieClassification_IsValid(t *testing.T) {
+	for _, c := range ValidZombieClassifications() {
+		if !c.IsValid() {
+			t.Errorf("%q.IsValid() = false", c)
+		}
+	}
+	for _, c := range []ZombieClassification{"", "stuck", "RATE-LIMITED"} {
+		if c.IsValid() {
+			t.Errorf("%q.IsValid() = true", c)
+		}
+	}
+}
+
+// TestValidZombieClassifications_MatchesConstants keeps
+// ValidZombieClassifications in sync with the constants declared in
+// handlers.go, so a new classification can't be forgotten.
+func TestValidZombieClassifications_MatchesConstants(t *testing.T) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "handlers.go", nil, 0)
+	if err != nil {
+		t.Fatalf("parsing handlers.go: %v", err)
+	}
+
+	var declared []string
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if ident, ok := vs.Type.(*ast.Ident); ok && ident.Name == "ZombieClassification" {
+				for _, name := range vs.Names {
+					declared = append(declared, name.Name)
+				}
+			}
+		}
+	}
+
+	if len(declared) != len(ValidZombieClassifications()) {
+		t.Errorf("handlers.go declares %d ZombieClassification constants %v, ValidZombieClassifications returns %d",
+			len(declared), declared, len(ValidZombieClassifications()))
+	}
+}
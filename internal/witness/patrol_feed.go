@@ -0,0 +1,421 @@
+package witness
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/steveyegge/gastown/internal/buildinfo"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/lock"
+)
+
+// PatrolEventType identifies a step in a witness patrol run.
+type PatrolEventType string
+
+const (
+	// PatrolEventStarted marks the beginning of a patrol run.
+	PatrolEventStarted PatrolEventType = "patrol-started"
+	// PatrolEventExamined reports a polecat the patrol classified.
+	PatrolEventExamined PatrolEventType = "polecat-examined"
+	// PatrolEventAction reports an action the patrol took and its outcome.
+	PatrolEventAction PatrolEventType = "action-executed"
+	// PatrolEventFinished marks the end of a patrol run with a summary.
+	PatrolEventFinished PatrolEventType = "patrol-finished"
+)
+
+// PatrolFeedFile is the name of the patrol event feed inside a rig's .runtime.
+const PatrolFeedFile = "witness-patrol.jsonl"
+
+// DefaultPatrolFeedMaxBytes bounds the patrol feed. When an append would
+// exceed it, the feed is compacted to the newest half.
+const DefaultPatrolFeedMaxBytes int64 = 1 << 20
+
+// PatrolEvent is a single structured entry in the patrol feed.
+// RunID is shared by all events of one patrol so overlapping runs can be
+// told apart when their events interleave. Seq numbers a run's events from 1
+// in the order they were appended; RunID and Seq together identify an event.
+type PatrolEvent struct {
+	Time           time.Time           `json:"time"`
+	RunID          string              `json:"run_id"`
+	Seq            int64               `json:"seq,omitempty"`
+	Rig            string              `json:"rig"`
+	Type           PatrolEventType     `json:"type"`
+	Polecat        string              `json:"polecat,omitempty"`
+	Classification string              `json:"classification,omitempty"` // zombie classification or stall type
+	Action         string              `json:"action,omitempty"`
+	Outcome        string              `json:"outcome,omitempty"` // "ok" or the error message
+	Summary        *PatrolEventSummary `json:"summary,omitempty"`
+
+	// Provenance is set on patrol-started events only.
+	Provenance *buildinfo.Provenance `json:"provenance,omitempty"`
+}
+
+// PatrolEventSummary is attached to patrol-finished events.
+type PatrolEventSummary struct {
+	Checked     int `json:"checked"`
+	Zombies     int `json:"zombies"`
+	RateLimited int `json:"rate_limited,omitempty"` // polecats waiting for a quota reset; not counted in Zombies
+	Stalled     int `json:"stalled"`
+	Completions int `json:"completions"`
+	Errors      int `json:"errors"`
+}
+
+// PatrolFeedPath returns the patrol feed path for a rig.
+func PatrolFeedPath(townRoot, rigName string) string {
+	return filepath.Join(constants.RigRuntimePath(filepath.Join(townRoot, rigName)), PatrolFeedFile)
+}
+
+// patrolFeedMu serializes in-process appends. Cross-process serialization
+// uses a sibling .flock file, matching the respawn state file.
+var patrolFeedMu sync.Mutex
+
+// AppendPatrolEvent appends ev to the feed at path. If the append would grow
+// the file past maxBytes, the feed is first compacted to its newest lines
+// (at most maxBytes/2) via an atomic rename, so readers see either the old
+// or the new file, never a partial one. maxBytes <= 0 disables bounding.
+func AppendPatrolEvent(path string, ev PatrolEvent, maxBytes int64) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("marshaling patrol event: %w", err)
+	}
+	data = append(data, '\n')
+
+	patrolFeedMu.Lock()
+	defer patrolFeedMu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating runtime dir: %w", err)
+	}
+	unlock, err := lock.FlockAcquire(path + ".flock")
+	if err != nil {
+		return fmt.Errorf("locking patrol feed: %w", err)
+	}
+	defer unlock()
+
+	if maxBytes > 0 {
+		if info, err := os.Stat(path); err == nil && info.Size()+int64(len(data)) > maxBytes {
+			if err := compactPatrolFeed(path, maxBytes/2); err != nil {
+				return err
+			}
+		}
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) //nolint:gosec // G302: feed is non-sensitive operational data
+	if err != nil {
+		return fmt.Errorf("opening patrol feed: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing patrol event: %w", err)
+	}
+	return f.Close()
+}
+
+// compactPatrolFeed rewrites path keeping only the newest complete lines
+// that fit in keepBytes.
+func compactPatrolFeed(path string, keepBytes int64) error {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path is under the rig runtime dir
+	if err != nil {
+		return fmt.Errorf("reading patrol feed: %w", err)
+	}
+	// Drop any trailing partial line, then walk back line by line.
+	if i := bytes.LastIndexByte(data, '\n'); i >= 0 {
+		data = data[:i+1]
+	} else {
+		data = nil
+	}
+	start := len(data)
+	for start > 0 {
+		prev := bytes.LastIndexByte(data[:start-1], '\n') + 1
+		if int64(len(data)-prev) > keepBytes {
+			break
+		}
+		start = prev
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data[start:], 0644); err != nil { //nolint:gosec // G306: feed is non-sensitive operational data
+		return fmt.Errorf("writing compacted patrol feed: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("replacing patrol feed: %w", err)
+	}
+	return nil
+}
+
+// PatrolFeed emits the events of a single patrol run. Appends are
+// best-effort: a feed that cannot be written never fails the patrol.
+type PatrolFeed struct {
+	path        string
+	rig         string
+	runID       string
+	fingerprint string
+	maxBytes    int64
+	now         func() time.Time
+
+	mu  sync.Mutex // keeps Seq order equal to append order
+	seq int64
+}
+
+// NewPatrolFeed returns a feed for one patrol run in rigName with a fresh run ID.
+func NewPatrolFeed(townRoot, rigName string) *PatrolFeed {
+	return &PatrolFeed{
+		path:        PatrolFeedPath(townRoot, rigName),
+		rig:         rigName,
+		runID:       uuid.NewString()[:8],
+		fingerprint: buildinfo.ConfigFingerprint(filepath.Join(townRoot, rigName, "config.json")),
+		maxBytes:    DefaultPatrolFeedMaxBytes,
+		now:         time.Now,
+	}
+}
+
+// RunID returns the run ID stamped on every event from this feed.
+func (f *PatrolFeed) RunID() string {
+	return f.runID
+}
+
+// Emit stamps ev with the run ID, next sequence number, rig, and current
+// time and appends it.
+func (f *PatrolFeed) Emit(ev PatrolEvent) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.seq++
+	ev.Time = f.now().UTC()
+	ev.RunID = f.runID
+	ev.Seq = f.seq
+	ev.Rig = f.rig
+	_ = AppendPatrolEvent(f.path, ev, f.maxBytes)
+}
+
+// Started emits the patrol-started event, stamped with provenance.
+func (f *PatrolFeed) Started() {
+	if f == nil {
+		return
+	}
+	f.Emit(PatrolEvent{Type: PatrolEventStarted, Provenance: buildinfo.Stamp(f.fingerprint)})
+}
+
+// Record emits examined/action events for everything a patrol found,
+// followed by patrol-finished with a summary. Any result may be nil.
+func (f *PatrolFeed) Record(zombies *DetectZombiePolecatsResult, stalls *DetectStalledPolecatsResult, completions *DiscoverCompletionsResult) {
+	var sum PatrolEventSummary
+
+	if zombies != nil {
+		sum.Checked += zombies.Checked
+		sum.RateLimited = zombies.RateLimited()
+		sum.Zombies = len(zombies.Zombies) - sum.RateLimited
+		sum.Errors += len(zombies.Errors)
+		for _, z := range zombies.Zombies {
+			f.Emit(PatrolEvent{Type: PatrolEventExamined, Polecat: z.PolecatName, Classification: string(z.Classification)})
+			if z.Action != "" || z.Error != nil {
+				f.Emit(PatrolEvent{Type: PatrolEventAction, Polecat: z.PolecatName, Action: z.Action, Outcome: patrolOutcome(z.Error)})
+			}
+		}
+	}
+	if stalls != nil {
+		sum.Stalled = len(stalls.Stalled)
+		sum.Errors += len(stalls.Errors)
+		for _, s := range stalls.Stalled {
+			f.Emit(PatrolEvent{Type: PatrolEventExamined, Polecat: s.PolecatName, Classification: s.StallType})
+			if s.Action != "" || s.Error != nil {
+				f.Emit(PatrolEvent{Type: PatrolEventAction, Polecat: s.PolecatName, Action: s.Action, Outcome: patrolOutcome(s.Error)})
+			}
+		}
+	}
+	if completions != nil {
+		sum.Completions = len(completions.Discovered)
+		sum.Errors += len(completions.Errors)
+		for _, c := range completions.Discovered {
+			f.Emit(PatrolEvent{Type: PatrolEventAction, Polecat: c.PolecatName, Action: c.Action, Outcome: patrolOutcome(c.Error)})
+		}
+	}
+
+	f.Emit(PatrolEvent{Type: PatrolEventFinished, Summary: &sum})
+}
+
+func patrolOutcome(err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return "ok"
+}
+
+// PatrolFeedReader tails a patrol feed by polling its size and offset.
+//
+// Malformed lines are skipped. A trailing line without a newline is left
+// unread until it is complete. If the file is replaced (compaction) or
+// truncated in place, the reader restarts from the beginning and suppresses
+// events it already delivered, identified by RunID and Seq, so a resumed
+// tail neither loses nor repeats events even when overlapping runs append
+// out of timestamp order. Events written without a Seq fall back to
+// suppressing anything not newer than the last delivered time.
+type PatrolFeedReader struct {
+	path   string
+	offset int64
+	seen   map[string]int64 // highest Seq delivered per RunID
+	last   time.Time        // time of the newest event delivered
+	replay time.Time        // after a reset, skip unsequenced events at or before this time
+	info   os.FileInfo
+}
+
+// NewPatrolFeedReader returns a reader positioned at offset. Use offset 0 to
+// read the whole feed, or PatrolFeedEnd to start with only new events.
+// Events before offset count as already delivered, so a reset after
+// compaction replays only from the position the reader was opened at.
+func NewPatrolFeedReader(path string, offset int64) *PatrolFeedReader {
+	r := &PatrolFeedReader{path: path, offset: offset, seen: make(map[string]int64)}
+	if info, err := os.Stat(path); err == nil {
+		r.info = info
+	}
+	if offset > 0 {
+		r.markDeliveredBefore(offset)
+	}
+	return r
+}
+
+// markDeliveredBefore records every event in the first offset bytes of the
+// feed as delivered.
+func (r *PatrolFeedReader) markDeliveredBefore(offset int64) {
+	f, err := os.Open(r.path) //nolint:gosec // G304: path is under the rig runtime dir
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	br := bufio.NewReader(io.LimitReader(f, offset))
+	for {
+		line, err := br.ReadBytes('\n')
+		if err != nil {
+			break
+		}
+		var ev PatrolEvent
+		if json.Unmarshal(bytes.TrimSpace(line), &ev) == nil {
+			r.markDelivered(ev)
+		}
+	}
+}
+
+// markDelivered records ev as delivered.
+func (r *PatrolFeedReader) markDelivered(ev PatrolEvent) {
+	if ev.Seq > r.seen[ev.RunID] {
+		r.seen[ev.RunID] = ev.Seq
+	}
+	if ev.Time.After(r.last) {
+		r.last = ev.Time
+	}
+}
+
+// delivered reports whether ev was already returned before a reset.
+func (r *PatrolFeedReader) delivered(ev PatrolEvent) bool {
+	if ev.Seq > 0 {
+		return ev.Seq <= r.seen[ev.RunID]
+	}
+	return !r.replay.IsZero() && !ev.Time.After(r.replay)
+}
+
+// PatrolFeedEnd returns the current size of the feed at path, or 0 if it
+// does not exist yet.
+func PatrolFeedEnd(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+// Offset returns the byte offset of the next unread line.
+func (r *PatrolFeedReader) Offset() int64 {
+	return r.offset
+}
+
+// Poll returns the complete events appended since the previous call.
+// A missing feed yields no events and no error.
+func (r *PatrolFeedReader) Poll() ([]PatrolEvent, error) {
+	f, err := os.Open(r.path) //nolint:gosec // G304: path is under the rig runtime dir
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("opening patrol feed: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("stat patrol feed: %w", err)
+	}
+	replaced := r.info != nil && !os.SameFile(r.info, info)
+	r.info = info
+	reset := replaced || info.Size() < r.offset || !atLineStart(f, r.offset)
+	if reset {
+		r.offset = 0
+		r.replay = r.last
+	}
+	if info.Size() == r.offset {
+		return nil, nil
+	}
+
+	if _, err := f.Seek(r.offset, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("seeking patrol feed: %w", err)
+	}
+
+	var events []PatrolEvent
+	present := make(map[string]bool) // runs still in the feed, after a reset
+	br := bufio.NewReader(f)
+	for {
+		line, err := br.ReadBytes('\n')
+		if err != nil {
+			// EOF with a partial line: leave it for the next poll.
+			break
+		}
+		r.offset += int64(len(line))
+
+		var ev PatrolEvent
+		if json.Unmarshal(bytes.TrimSpace(line), &ev) != nil || ev.Type == "" {
+			continue
+		}
+		present[ev.RunID] = true
+		if r.delivered(ev) {
+			continue
+		}
+		buildinfo.NoteLoaded(r.path, ev.Provenance)
+		r.markDelivered(ev)
+		events = append(events, ev)
+	}
+	if reset {
+		// A run compacted out of the feed cannot reappear; forget it so
+		// seen stays bounded by the feed's contents.
+		for runID := range r.seen {
+			if !present[runID] {
+				delete(r.seen, runID)
+			}
+		}
+	}
+	return events, nil
+}
+
+// atLineStart reports whether offset begins a line in f: either it is 0 or
+// the preceding byte is a newline. A mid-line offset means the file was
+// truncated in place and has since regrown past the old position.
+func atLineStart(f *os.File, offset int64) bool {
+	if offset == 0 {
+		return true
+	}
+	var b [1]byte
+	if _, err := f.ReadAt(b[:], offset-1); err != nil {
+		return false
+	}
+	return b[0] == '\n'
+}
@@ -0,0 +1,326 @@
+package witness
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testFeed(t *testing.T) (*PatrolFeed, string) {
+	t.Helper()
+	townRoot := t.TempDir()
+	f := NewPatrolFeed(townRoot, "gastown")
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	n := 0
+	f.now = func() time.Time {
+		n++
+		return base.Add(time.Duration(n) * time.Millisecond)
+	}
+	return f, f.path
+}
+
+func TestPatrolFeedPath(t *testing.T) {
+	got := PatrolFeedPath("/town", "gastown")
+	want := filepath.Join("/town", "gastown", ".runtime", PatrolFeedFile)
+	if got != want {
+		t.Errorf("PatrolFeedPath = %q, want %q", got, want)
+	}
+}
+
+func TestAppendPatrolEvent_Bounded(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PatrolFeedFile)
+	const maxBytes = 2048
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 200; i++ {
+		ev := PatrolEvent{Time: base.Add(time.Duration(i) * time.Second), RunID: "r1", Rig: "gastown",
+			Type: PatrolEventExamined, Polecat: "toast"}
+		if err := AppendPatrolEvent(path, ev, maxBytes); err != nil {
+			t.Fatalf("append %d: %v", i, err)
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Size() > maxBytes {
+			t.Fatalf("feed grew to %d bytes, bound is %d", info.Size(), maxBytes)
+		}
+	}
+
+	events, err := NewPatrolFeedReader(path, 0).Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) == 0 {
+		t.Fatal("compaction dropped every event")
+	}
+	// The newest event must survive compaction, and order is preserved.
+	if last := events[len(events)-1]; !last.Time.Equal(base.Add(199 * time.Second)) {
+		t.Errorf("newest retained event at %v, want %v", last.Time, base.Add(199*time.Second))
+	}
+	for i := 1; i < len(events); i++ {
+		if !events[i].Time.After(events[i-1].Time) {
+			t.Fatalf("events out of order at %d", i)
+		}
+	}
+}
+
+func TestAppendPatrolEvent_CompactionDropsPartialLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PatrolFeedFile)
+	if err := os.WriteFile(path, []byte(strings.Repeat("x", 600)+"\n{\"type\":\"patrol-sta"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ev := PatrolEvent{Time: time.Now(), RunID: "r1", Type: PatrolEventStarted}
+	if err := AppendPatrolEvent(path, ev, 512); err != nil {
+		t.Fatal(err)
+	}
+	events, err := NewPatrolFeedReader(path, 0).Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].RunID != "r1" {
+		t.Errorf("events after compaction = %+v, want the single appended event", events)
+	}
+}
+
+func TestPatrolFeedReader_SkipsMalformedAndWaitsForPartial(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PatrolFeedFile)
+	content := `{"time":"2026-01-01T00:00:01Z","run_id":"a","type":"patrol-started"}
+not json at all
+{"time":"2026-01-01T00:00:02Z","run_id":"a","type":"patrol-finished"}
+{"time":"2026-01-01T00:00:03Z","run_id":"b","ty`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewPatrolFeedReader(path, 0)
+	events, err := r.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2 (malformed skipped, partial deferred)", len(events))
+	}
+
+	// Completing the partial line makes it visible on the next poll.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(`pe":"patrol-started"}` + "\n")
+	_ = f.Close()
+
+	events, err = r.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].RunID != "b" {
+		t.Errorf("after completing partial line got %+v", events)
+	}
+}
+
+func TestPatrolFeedReader_ResumesAcrossTruncation(t *testing.T) {
+	feed, path := testFeed(t)
+	feed.maxBytes = 0
+
+	for i := 0; i < 5; i++ {
+		feed.Started()
+	}
+	r := NewPatrolFeedReader(path, 0)
+	events, err := r.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 5 {
+		t.Fatalf("initial poll got %d events, want 5", len(events))
+	}
+	resumeAt := r.Offset()
+
+	// A new reader resuming from the saved offset sees only new events.
+	feed.Started()
+	r = NewPatrolFeedReader(path, resumeAt)
+	if events, _ = r.Poll(); len(events) != 1 {
+		t.Fatalf("resumed poll got %d events, want 1", len(events))
+	}
+
+	// Compact the feed behind the reader's back (file replaced and smaller
+	// than the offset), then append. Only the genuinely new event is seen.
+	if err := compactPatrolFeed(path, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := compactPatrolFeed(path, 200); err != nil {
+		t.Fatal(err)
+	}
+	feed.Emit(PatrolEvent{Type: PatrolEventFinished})
+
+	events, err = r.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Type != PatrolEventFinished {
+		t.Fatalf("after truncation got %+v, want only the new patrol-finished", events)
+	}
+
+	// In-place truncation to empty is handled the same way.
+	if err := os.Truncate(path, 0); err != nil {
+		t.Fatal(err)
+	}
+	feed.Started()
+	if events, _ = r.Poll(); len(events) != 1 || events[0].Type != PatrolEventStarted {
+		t.Fatalf("after in-place truncation got %+v", events)
+	}
+}
+
+func TestPatrolFeedReader_CompactionAfterOpenAtEnd(t *testing.T) {
+	feed, path := testFeed(t)
+	feed.maxBytes = 0
+
+	for i := 0; i < 3; i++ {
+		feed.Started()
+	}
+	// Opened at the end and nothing delivered yet: compaction must not
+	// replay the events that were already in the feed at open.
+	r := NewPatrolFeedReader(path, PatrolFeedEnd(path))
+	if err := compactPatrolFeed(path, 1<<20); err != nil {
+		t.Fatal(err)
+	}
+	feed.Emit(PatrolEvent{Type: PatrolEventFinished})
+
+	events, err := r.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Type != PatrolEventFinished {
+		t.Fatalf("after compaction got %+v, want only the new patrol-finished", events)
+	}
+}
+
+func TestPatrolFeedReader_CompactionKeepsOverlappingRunOutOfTimeOrder(t *testing.T) {
+	feed, path := testFeed(t)
+	feed.maxBytes = 0
+
+	// A second patrol overlaps the first; its clock reads earlier, so its
+	// event lands after a newer-stamped one.
+	other := &PatrolFeed{path: path, rig: "gastown", runID: "overlap", now: func() time.Time {
+		return time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	}}
+
+	feed.Started()
+	r := NewPatrolFeedReader(path, 0)
+	if events, _ := r.Poll(); len(events) != 1 {
+		t.Fatalf("initial poll got %d events, want 1", len(events))
+	}
+
+	other.Started()
+	if err := compactPatrolFeed(path, 1<<20); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := r.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].RunID != "overlap" || events[0].Seq != 1 {
+		t.Fatalf("after compaction got %+v, want only the overlapping run's event", events)
+	}
+}
+
+func TestPatrolFeedReader_MissingFile(t *testing.T) {
+	r := NewPatrolFeedReader(filepath.Join(t.TempDir(), "nope.jsonl"), 0)
+	events, err := r.Poll()
+	if err != nil || len(events) != 0 {
+		t.Errorf("Poll(missing) = (%v, %v), want no events and no error", events, err)
+	}
+}
+
+func TestPatrolFeed_RecordOrdering(t *testing.T) {
+	feed, path := testFeed(t)
+
+	// A mocked patrol run: one zombie restarted, one stalled polecat whose
+	// dismissal failed, and one completion.
+	feed.Started()
+	feed.Record(
+		&DetectZombiePolecatsResult{
+			Checked: 3,
+			Zombies: []ZombieResult{{PolecatName: "toast", Classification: ZombieSessionDeadActive, Action: "restarted"}},
+		},
+		&DetectStalledPolecatsResult{
+			Checked: 2,
+			Stalled: []StalledResult{{PolecatName: "nux", StallType: "startup-stall", Action: "escalated", Error: errors.New("nudge failed")}},
+		},
+		&DiscoverCompletionsResult{
+			Checked:    3,
+			Discovered: []CompletionDiscovery{{PolecatName: "slit", Action: "merge-ready-sent"}},
+		},
+	)
+
+	events, err := NewPatrolFeedReader(path, 0).Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		typ     PatrolEventType
+		polecat string
+		detail  string
+	}{
+		{PatrolEventStarted, "", ""},
+		{PatrolEventExamined, "toast", string(ZombieSessionDeadActive)},
+		{PatrolEventAction, "toast", "ok"},
+		{PatrolEventExamined, "nux", "startup-stall"},
+		{PatrolEventAction, "nux", "nudge failed"},
+		{PatrolEventAction, "slit", "ok"},
+		{PatrolEventFinished, "", ""},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		ev := events[i]
+		if ev.Type != w.typ || ev.Polecat != w.polecat {
+			t.Errorf("event %d = %s/%s, want %s/%s", i, ev.Type, ev.Polecat, w.typ, w.polecat)
+		}
+		if ev.RunID != feed.RunID() || ev.Rig != "gastown" {
+			t.Errorf("event %d run/rig = %s/%s, want %s/gastown", i, ev.RunID, ev.Rig, feed.RunID())
+		}
+		switch ev.Type {
+		case PatrolEventExamined:
+			if ev.Classification != w.detail {
+				t.Errorf("event %d classification = %q, want %q", i, ev.Classification, w.detail)
+			}
+		case PatrolEventAction:
+			if ev.Outcome != w.detail {
+				t.Errorf("event %d outcome = %q, want %q", i, ev.Outcome, w.detail)
+			}
+		}
+	}
+
+	sum := events[len(events)-1].Summary
+	if sum == nil || sum.Checked != 3 || sum.Zombies != 1 || sum.Stalled != 1 || sum.Completions != 1 {
+		t.Errorf("summary = %+v", sum)
+	}
+}
+
+func TestPatrolFeed_RecordCountsRateLimitedSeparately(t *testing.T) {
+	feed, path := testFeed(t)
+
+	feed.Record(&DetectZombiePolecatsResult{
+		Checked: 2,
+		Zombies: []ZombieResult{
+			{PolecatName: "toast", Classification: ZombieSessionDeadActive, Action: "restarted"},
+			{PolecatName: "nux", Classification: ZombieRateLimited, Action: "waiting-for-reset"},
+		},
+	}, nil, nil)
+
+	events, err := NewPatrolFeedReader(path, 0).Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := events[len(events)-1].Summary
+	if sum == nil || sum.Zombies != 1 || sum.RateLimited != 1 {
+		t.Errorf("summary = %+v, want 1 zombie and 1 rate-limited", sum)
+	}
+}
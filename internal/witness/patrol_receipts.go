@@ -1,6 +1,10 @@
 package witness
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 // PatrolVerdict classifies witness patrol outcomes for machine consumers.
 type PatrolVerdict string
@@ -8,6 +12,15 @@ type PatrolVerdict string
 const (
 	PatrolVerdictStale  PatrolVerdict = "stale"
 	PatrolVerdictOrphan PatrolVerdict = "orphan"
+	// PatrolVerdictNone is informational: the polecat was examined but needs
+	// no action (e.g. rate-limited and waiting for its quota to reset).
+	PatrolVerdictNone PatrolVerdict = "none"
+	// PatrolVerdictPending: the session appears dead but is still within the
+	// detector's grace period. Re-examined on the next patrol.
+	PatrolVerdictPending PatrolVerdict = "pending"
+	// PatrolVerdictUnknown: the zombie carries a classification this version
+	// does not recognize. Needs a human look rather than a guessed verdict.
+	PatrolVerdictUnknown PatrolVerdict = "unknown"
 )
 
 // PatrolReceiptEvidence captures the primary evidence fields for a verdict.
@@ -16,6 +29,7 @@ type PatrolReceiptEvidence struct {
 	Classification ZombieClassification `json:"classification,omitempty"` // Typed zombie reason (gt-tsut)
 	HookBead       string               `json:"hook_bead,omitempty"`
 	BeadRecovered  bool                 `json:"bead_recovered"`
+	ResetsAt       string               `json:"resets_at,omitempty"` // Quota reset time for rate-limited polecats
 	Error          string               `json:"error,omitempty"`
 }
 
@@ -24,25 +38,69 @@ type PatrolReceipt struct {
 	Rig               string                `json:"rig"`
 	Polecat           string                `json:"polecat"`
 	Verdict           PatrolVerdict         `json:"verdict"`
+	Severity          int                   `json:"severity"`
 	RecommendedAction string                `json:"recommended_action"`
 	Evidence          PatrolReceiptEvidence `json:"evidence"`
+
+	// Patrol-level metadata, set by the *WithMeta/*WithDuration builders.
+	ScanDurationMs  int64 `json:"scan_duration_ms,omitempty"` // How long the zombie scan took
+	SessionsChecked int   `json:"sessions_checked,omitempty"` // Polecat sessions examined by the scan
+}
+
+// EvidenceString renders the receipt as a one-line incident summary, e.g.
+//
+//	Polecat: atlas | Verdict: stale | Hook: gt-abc123 | Error: nuke failed | Action: restarted
+//
+// Empty evidence fields are omitted; the rest always appear in this order.
+func (r PatrolReceipt) EvidenceString() string {
+	fields := []string{
+		"Polecat: " + r.Polecat,
+		"Verdict: " + string(r.Verdict),
+	}
+	add := func(label, value string) {
+		if value != "" {
+			fields = append(fields, label+": "+value)
+		}
+	}
+	add("Reason", string(r.Evidence.Classification))
+	add("State", r.Evidence.AgentState)
+	add("Hook", r.Evidence.HookBead)
+	if r.Evidence.BeadRecovered {
+		fields = append(fields, "Bead: recovered")
+	}
+	add("Resets", r.Evidence.ResetsAt)
+	add("Error", r.Evidence.Error)
+	if r.Severity != 0 {
+		fields = append(fields, fmt.Sprintf("Severity: %d", r.Severity))
+	}
+	add("Action", r.RecommendedAction)
+	return strings.Join(fields, " | ")
 }
 
 // receiptVerdictForZombie derives the patrol verdict from the zombie's typed
-// Classification field rather than re-deriving from raw strings. Falls back to
-// WasActive for forward-compatibility with unknown classifications. See gt-tsut.
+// Classification field rather than re-deriving from raw strings.
+// Classifications that imply active work are stale; the rest are mapped
+// explicitly, and an unrecognized one yields PatrolVerdictUnknown.
+// ZombieResults without a Classification fall back to WasActive. See gt-tsut.
 func receiptVerdictForZombie(z ZombieResult) PatrolVerdict {
-	if z.Classification != "" {
-		if z.Classification.ImpliesActiveWork() {
+	if z.Classification.ImpliesActiveWork() {
+		return PatrolVerdictStale
+	}
+	switch z.Classification {
+	case ZombieIdleDirtySandbox:
+		return PatrolVerdictOrphan
+	case ZombieRateLimited:
+		return PatrolVerdictNone
+	case ZombiePending:
+		return PatrolVerdictPending
+	case "":
+		if z.WasActive {
 			return PatrolVerdictStale
 		}
 		return PatrolVerdictOrphan
+	default:
+		return PatrolVerdictUnknown
 	}
-	// Fallback for ZombieResults without Classification (forward-compat)
-	if z.WasActive {
-		return PatrolVerdictStale
-	}
-	return PatrolVerdictOrphan
 }
 
 // BuildPatrolReceipt projects a zombie patrol result into a stable JSON-ready receipt.
@@ -51,17 +109,23 @@ func BuildPatrolReceipt(rigName string, z ZombieResult) PatrolReceipt {
 	if action == "" {
 		action = "investigate"
 	}
+	severity := z.Severity
+	if severity == 0 {
+		severity = SeverityForClassification(z.Classification)
+	}
 
 	receipt := PatrolReceipt{
 		Rig:               rigName,
 		Polecat:           z.PolecatName,
 		Verdict:           receiptVerdictForZombie(z),
+		Severity:          severity,
 		RecommendedAction: action,
 		Evidence: PatrolReceiptEvidence{
 			AgentState:     z.AgentState,
 			Classification: z.Classification,
 			HookBead:       z.HookBead,
 			BeadRecovered:  z.BeadRecovered,
+			ResetsAt:       z.ResetsAt,
 		},
 	}
 
@@ -72,6 +136,15 @@ func BuildPatrolReceipt(rigName string, z ZombieResult) PatrolReceipt {
 	return receipt
 }
 
+// BuildPatrolReceiptWithMeta is BuildPatrolReceipt plus the metadata of the
+// patrol scan that produced z.
+func BuildPatrolReceiptWithMeta(rigName string, z ZombieResult, scanDuration time.Duration, sessionsChecked int) PatrolReceipt {
+	receipt := BuildPatrolReceipt(rigName, z)
+	receipt.ScanDurationMs = scanDuration.Milliseconds()
+	receipt.SessionsChecked = sessionsChecked
+	return receipt
+}
+
 // BuildPatrolReceipts returns machine-readable patrol verdicts for all detected zombies.
 func BuildPatrolReceipts(rigName string, result *DetectZombiePolecatsResult) []PatrolReceipt {
 	if result == nil || len(result.Zombies) == 0 {
@@ -83,3 +156,16 @@ func BuildPatrolReceipts(rigName string, result *DetectZombiePolecatsResult) []P
 	}
 	return receipts
 }
+
+// BuildPatrolReceiptsWithDuration is BuildPatrolReceipts with each receipt
+// carrying the scan duration and the number of sessions result checked.
+func BuildPatrolReceiptsWithDuration(rigName string, result *DetectZombiePolecatsResult, scanDuration time.Duration) []PatrolReceipt {
+	if result == nil || len(result.Zombies) == 0 {
+		return nil
+	}
+	receipts := make([]PatrolReceipt, 0, len(result.Zombies))
+	for _, zombie := range result.Zombies {
+		receipts = append(receipts, BuildPatrolReceiptWithMeta(rigName, zombie, scanDuration, result.Checked))
+	}
+	return receipts
+}
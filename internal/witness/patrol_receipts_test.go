@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 )
 
 func TestBuildPatrolReceipt_StaleVerdictFromHookBead(t *testing.T) {
@@ -71,6 +72,8 @@ func TestReceiptVerdictForZombie_AllStates(t *testing.T) {
 		{name: "done-intent-dead", classification: ZombieDoneIntentDead, wasActive: true, want: PatrolVerdictStale},
 		{name: "session-dead-active", classification: ZombieSessionDeadActive, wasActive: true, want: PatrolVerdictStale},
 		{name: "idle-dirty-sandbox", classification: ZombieIdleDirtySandbox, want: PatrolVerdictOrphan},
+		{name: "rate-limited", classification: ZombieRateLimited, hookBead: "gt-1", want: PatrolVerdictNone},
+		{name: "rate-limited ignores wasActive", classification: ZombieRateLimited, wasActive: true, want: PatrolVerdictNone},
 
 		// Real agent states with classification
 		{name: "active working", state: "working", classification: ZombieSessionDeadActive, wasActive: true, want: PatrolVerdictStale},
@@ -153,6 +156,44 @@ func TestBuildPatrolReceipts_JSONShape(t *testing.T) {
 	}
 }
 
+func TestBuildPatrolReceipt_RateLimitedJSON(t *testing.T) {
+	t.Parallel()
+	receipt := BuildPatrolReceipt("gastown", ZombieResult{
+		PolecatName:    "atlas",
+		AgentState:     "working",
+		Classification: ZombieRateLimited,
+		HookBead:       "gt-123",
+		ResetsAt:       "7pm (America/Los_Angeles)",
+		Action:         "waiting-for-reset",
+	})
+
+	data, err := json.Marshal(receipt)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if decoded["verdict"] != string(PatrolVerdictNone) {
+		t.Errorf("decoded verdict = %v, want %q", decoded["verdict"], PatrolVerdictNone)
+	}
+	if decoded["recommended_action"] != "waiting-for-reset" {
+		t.Errorf("decoded recommended_action = %v, want %q", decoded["recommended_action"], "waiting-for-reset")
+	}
+	evidence, ok := decoded["evidence"].(map[string]any)
+	if !ok {
+		t.Fatalf("decoded evidence missing or wrong type: %#v", decoded["evidence"])
+	}
+	if evidence["classification"] != string(ZombieRateLimited) {
+		t.Errorf("decoded evidence.classification = %v, want %q", evidence["classification"], ZombieRateLimited)
+	}
+	if evidence["resets_at"] != "7pm (America/Los_Angeles)" {
+		t.Errorf("decoded evidence.resets_at = %v, want reset time", evidence["resets_at"])
+	}
+}
+
 func TestBuildPatrolReceipts_DeterministicStaleOrphanOrdering(t *testing.T) {
 	t.Parallel()
 	receipts := BuildPatrolReceipts("gastown", &DetectZombiePolecatsResult{
@@ -181,3 +222,120 @@ func TestBuildPatrolReceipts_DeterministicStaleOrphanOrdering(t *testing.T) {
 		t.Fatalf("second receipt = %+v, want polecat=echo verdict=%q", receipts[1], PatrolVerdictOrphan)
 	}
 }
+
+func TestBuildPatrolReceipt_Severity(t *testing.T) {
+	t.Parallel()
+	receipt := BuildPatrolReceipt("gastown", ZombieResult{
+		PolecatName:    "atlas",
+		Classification: ZombieSessionDeadActive,
+	})
+	if receipt.Severity != SeverityCritical {
+		t.Fatalf("Severity = %d, want %d (derived from classification)", receipt.Severity, SeverityCritical)
+	}
+
+	receipt = BuildPatrolReceipt("gastown", ZombieResult{
+		PolecatName:    "atlas",
+		Classification: ZombieSessionDeadActive,
+		Severity:       SeverityLow,
+	})
+	if receipt.Severity != SeverityLow {
+		t.Fatalf("Severity = %d, want explicit %d", receipt.Severity, SeverityLow)
+	}
+}
+
+func TestBuildPatrolReceiptWithMeta_JSON(t *testing.T) {
+	t.Parallel()
+	receipt := BuildPatrolReceiptWithMeta("gastown", ZombieResult{
+		PolecatName:    "atlas",
+		Classification: ZombieSessionDeadActive,
+	}, 1500*time.Millisecond, 7)
+
+	data, err := json.Marshal(receipt)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if got := decoded["scan_duration_ms"]; got != float64(1500) {
+		t.Errorf("scan_duration_ms = %v, want 1500", got)
+	}
+	if got := decoded["sessions_checked"]; got != float64(7) {
+		t.Errorf("sessions_checked = %v, want 7", got)
+	}
+}
+
+func TestBuildPatrolReceiptsWithDuration(t *testing.T) {
+	t.Parallel()
+	result := &DetectZombiePolecatsResult{
+		Checked: 3,
+		Zombies: []ZombieResult{{PolecatName: "atlas"}, {PolecatName: "nux"}},
+	}
+	receipts := BuildPatrolReceiptsWithDuration("gastown", result, 2*time.Second)
+	if len(receipts) != 2 {
+		t.Fatalf("len(receipts) = %d, want 2", len(receipts))
+	}
+	for _, r := range receipts {
+		if r.ScanDurationMs != 2000 || r.SessionsChecked != 3 {
+			t.Errorf("%s: ScanDurationMs=%d SessionsChecked=%d, want 2000 and 3", r.Polecat, r.ScanDurationMs, r.SessionsChecked)
+		}
+	}
+	if got := BuildPatrolReceiptsWithDuration("gastown", nil, time.Second); got != nil {
+		t.Errorf("nil result = %v, want nil", got)
+	}
+}
+
+func TestPatrolReceipt_EvidenceString(t *testing.T) {
+	t.Parallel()
+	receipt := BuildPatrolReceipt("gastown", ZombieResult{
+		PolecatName:    "atlas",
+		AgentState:     "working",
+		Classification: ZombieSessionDeadActive,
+		HookBead:       "gt-abc123",
+		BeadRecovered:  true,
+		Action:         "restarted",
+		Error:          errors.New("nuke failed"),
+	})
+
+	want := "Polecat: atlas | Verdict: stale | Reason: session-dead-active | State: working | " +
+		"Hook: gt-abc123 | Bead: recovered | Error: nuke failed | Severity: 4 | Action: restarted"
+	if got := receipt.EvidenceString(); got != want {
+		t.Errorf("EvidenceString() =\n  %q\nwant\n  %q", got, want)
+	}
+
+	// Empty evidence fields are left out.
+	minimal := PatrolReceipt{Polecat: "nux", Verdict: PatrolVerdictOrphan, RecommendedAction: "investigate"}
+	if got, want := minimal.EvidenceString(), "Polecat: nux | Verdict: orphan | Action: investigate"; got != want {
+		t.Errorf("EvidenceString() = %q, want %q", got, want)
+	}
+}
+
+func TestReceiptVerdictForZombie_AllClassificationsHandled(t *testing.T) {
+	t.Parallel()
+	want := map[ZombieClassification]PatrolVerdict{
+		ZombieStuckInDone:            PatrolVerdictStale,
+		ZombieAgentDeadInSession:     PatrolVerdictStale,
+		ZombieBeadClosedStillRunning: PatrolVerdictStale,
+		ZombieDoneIntentDead:         PatrolVerdictStale,
+		ZombieSessionDeadActive:      PatrolVerdictStale,
+		ZombieAgentSelfReportedStuck: PatrolVerdictStale,
+		ZombieIdleDirtySandbox:       PatrolVerdictOrphan,
+		ZombieRateLimited:            PatrolVerdictNone,
+		ZombiePending:                PatrolVerdictPending,
+	}
+	for _, c := range ValidZombieClassifications() {
+		w, ok := want[c]
+		if !ok {
+			t.Errorf("classification %q has no expected verdict; add it to this table and to receiptVerdictForZombie", c)
+			continue
+		}
+		if got := receiptVerdictForZombie(ZombieResult{Classification: c}); got != w {
+			t.Errorf("classification %q produced verdict %q, want %q", c, got, w)
+		}
+	}
+
+	if got := receiptVerdictForZombie(ZombieResult{Classification: "from-the-future", WasActive: true}); got != PatrolVerdictUnknown {
+		t.Errorf("unrecognized classification produced verdict %q, want %q", got, PatrolVerdictUnknown)
+	}
+}
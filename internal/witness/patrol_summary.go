@@ -0,0 +1,95 @@
+package witness
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/util"
+)
+
+// PatrolSummaryFile is the name of the latest patrol summary inside a rig's .runtime.
+const PatrolSummaryFile = "patrol-summary.json"
+
+// PatrolSummary aggregates the receipts of one patrol run.
+type PatrolSummary struct {
+	Total            int       `json:"total"`
+	StaleCount       int       `json:"stale_count"`
+	OrphanCount      int       `json:"orphan_count"`
+	PendingCount     int       `json:"pending_count"`
+	NoneCount        int       `json:"none_count"`     // examined, no action needed (e.g. rate-limited)
+	UnknownCount     int       `json:"unknown_count"`  // unrecognized zombie classification
+	CriticalCount    int       `json:"critical_count"` // receipts with Severity >= SeverityHigh
+	AffectedPolecats []string  `json:"affected_polecats,omitempty"`
+	Timestamp        time.Time `json:"timestamp"`
+}
+
+// IsClean reports whether the patrol produced no receipts at all.
+func (s PatrolSummary) IsClean() bool {
+	return s.Total == 0
+}
+
+// SummarizePatrol counts receipts by verdict and severity. AffectedPolecats
+// is sorted and deduplicated.
+func SummarizePatrol(receipts []PatrolReceipt) PatrolSummary {
+	s := PatrolSummary{
+		Total:     len(receipts),
+		Timestamp: time.Now().UTC(),
+	}
+	seen := make(map[string]bool)
+	for _, r := range receipts {
+		switch r.Verdict {
+		case PatrolVerdictStale:
+			s.StaleCount++
+		case PatrolVerdictOrphan:
+			s.OrphanCount++
+		case PatrolVerdictPending:
+			s.PendingCount++
+		case PatrolVerdictNone:
+			s.NoneCount++
+		case PatrolVerdictUnknown:
+			s.UnknownCount++
+		}
+		if r.Severity >= SeverityHigh {
+			s.CriticalCount++
+		}
+		if r.Polecat != "" && !seen[r.Polecat] {
+			seen[r.Polecat] = true
+			s.AffectedPolecats = append(s.AffectedPolecats, r.Polecat)
+		}
+	}
+	sort.Strings(s.AffectedPolecats)
+	return s
+}
+
+// PatrolSummaryPath returns the latest patrol summary path for a rig.
+func PatrolSummaryPath(townRoot, rigName string) string {
+	return filepath.Join(constants.RigRuntimePath(filepath.Join(townRoot, rigName)), PatrolSummaryFile)
+}
+
+// SavePatrolSummary atomically replaces the summary at path.
+func SavePatrolSummary(path string, s PatrolSummary) error {
+	return util.EnsureDirAndWriteJSON(path, s)
+}
+
+// LoadPatrolSummary reads the summary at path. It returns (nil, nil) if no
+// patrol has written one yet.
+func LoadPatrolSummary(path string) (*PatrolSummary, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path from trusted townRoot
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var s PatrolSummary
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &s, nil
+}
@@ -0,0 +1,80 @@
+package witness
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSummarizePatrol_MixedVerdicts(t *testing.T) {
+	receipts := []PatrolReceipt{
+		{Polecat: "nux", Verdict: PatrolVerdictStale, Severity: SeverityCritical},
+		{Polecat: "atlas", Verdict: PatrolVerdictOrphan, Severity: SeverityMedium},
+		{Polecat: "echo", Verdict: PatrolVerdictStale, Severity: SeverityHigh},
+		{Polecat: "atlas", Verdict: PatrolVerdictOrphan, Severity: SeverityMedium},
+		{Polecat: "toast", Verdict: PatrolVerdictNone, Severity: SeverityLow},
+		{Polecat: "furiosa", Verdict: PatrolVerdictPending, Severity: SeverityLow},
+	}
+
+	s := SummarizePatrol(receipts)
+	if s.Total != 6 {
+		t.Errorf("Total = %d, want 6", s.Total)
+	}
+	if s.StaleCount != 2 {
+		t.Errorf("StaleCount = %d, want 2", s.StaleCount)
+	}
+	if s.OrphanCount != 2 {
+		t.Errorf("OrphanCount = %d, want 2", s.OrphanCount)
+	}
+	if s.PendingCount != 1 || s.NoneCount != 1 {
+		t.Errorf("PendingCount, NoneCount = %d, %d, want 1, 1", s.PendingCount, s.NoneCount)
+	}
+	if got := s.StaleCount + s.OrphanCount + s.PendingCount + s.NoneCount; got != s.Total {
+		t.Errorf("verdict counts sum to %d, want Total %d", got, s.Total)
+	}
+	if s.CriticalCount != 2 {
+		t.Errorf("CriticalCount = %d, want 2", s.CriticalCount)
+	}
+	want := []string{"atlas", "echo", "furiosa", "nux", "toast"}
+	if !reflect.DeepEqual(s.AffectedPolecats, want) {
+		t.Errorf("AffectedPolecats = %v, want %v", s.AffectedPolecats, want)
+	}
+	if s.Timestamp.IsZero() {
+		t.Error("Timestamp not set")
+	}
+	if s.IsClean() {
+		t.Error("IsClean() = true, want false")
+	}
+}
+
+func TestSummarizePatrol_Empty(t *testing.T) {
+	s := SummarizePatrol(nil)
+	if !s.IsClean() {
+		t.Errorf("IsClean() = false for empty patrol: %+v", s)
+	}
+}
+
+func TestPatrolSummary_SaveLoad(t *testing.T) {
+	townRoot := t.TempDir()
+	path := PatrolSummaryPath(townRoot, "gastown")
+	if want := filepath.Join(townRoot, "gastown", ".runtime", PatrolSummaryFile); path != want {
+		t.Fatalf("PatrolSummaryPath = %q, want %q", path, want)
+	}
+
+	got, err := LoadPatrolSummary(path)
+	if err != nil || got != nil {
+		t.Fatalf("LoadPatrolSummary(missing) = %v, %v; want nil, nil", got, err)
+	}
+
+	s := SummarizePatrol([]PatrolReceipt{{Polecat: "nux", Verdict: PatrolVerdictStale, Severity: SeverityCritical}})
+	if err := SavePatrolSummary(path, s); err != nil {
+		t.Fatalf("SavePatrolSummary: %v", err)
+	}
+	got, err = LoadPatrolSummary(path)
+	if err != nil {
+		t.Fatalf("LoadPatrolSummary: %v", err)
+	}
+	if got.Total != 1 || got.StaleCount != 1 || !got.Timestamp.Equal(s.Timestamp) {
+		t.Errorf("round trip = %+v, want %+v", got, s)
+	}
+}
@@ -29,15 +29,35 @@ const (
 // It prefers mayor/town.json over mayor/ directory as workspace marker.
 // Always continues to the outermost workspace, correctly handling nested
 // workspace structures (e.g., rig directories with their own mayor/town.json).
-// Does not resolve symlinks to stay consistent with os.Getwd().
+// Does not resolve symlinks to stay consistent with os.Getwd(), unless the
+// unresolved walk finds no town: a symlinked directory (e.g. a shared memory
+// dir) may point into a town its lexical parents are not part of, so the
+// walk is then repeated from the resolved path.
 func Find(startDir string) (string, error) {
 	absDir, err := filepath.Abs(startDir)
 	if err != nil {
 		return "", fmt.Errorf("resolving path: %w", err)
 	}
 
-	var primaryMatch, secondaryMatch string
+	primaryMatch, secondaryMatch := findMarkers(absDir)
+	if primaryMatch != "" {
+		return primaryMatch, nil
+	}
+	if resolved, err := filepath.EvalSymlinks(absDir); err == nil && resolved != absDir {
+		resolvedPrimary, resolvedSecondary := findMarkers(resolved)
+		if resolvedPrimary != "" {
+			return resolvedPrimary, nil
+		}
+		if secondaryMatch == "" {
+			secondaryMatch = resolvedSecondary
+		}
+	}
+	return secondaryMatch, nil
+}
 
+// findMarkers walks up from absDir and returns the outermost directories
+// holding the primary and secondary workspace markers ("" if none).
+func findMarkers(absDir string) (primaryMatch, secondaryMatch string) {
 	current := absDir
 	for {
 		// Always keep updating primaryMatch and secondaryMatch to find the outermost
@@ -54,10 +74,7 @@ func Find(startDir string) (string, error) {
 
 		parent := filepath.Dir(current)
 		if parent == current {
-			if primaryMatch != "" {
-				return primaryMatch, nil
-			}
-			return secondaryMatch, nil
+			return primaryMatch, secondaryMatch
 		}
 		current = parent
 	}
@@ -86,7 +103,7 @@ func FindFromCwd() (string, error) {
 
 // FindFromCwdOrError is like FindFromCwd but returns an error if not found.
 // It searches for a workspace starting from the CWD. If none is found, it
-// falls back to the GT_TOWN_ROOT or GT_ROOT environment variables.
+// falls back to the GT_ROOT (or legacy GT_TOWN_ROOT) environment variable.
 func FindFromCwdOrError() (string, error) {
 	cwd, err := os.Getwd()
 	if err == nil {
@@ -96,13 +113,11 @@ func FindFromCwdOrError() (string, error) {
 		}
 	}
 
-	// Fallback: try GT_TOWN_ROOT or GT_ROOT env vars (set by shell integration or session manager)
-	for _, envName := range []string{"GT_TOWN_ROOT", "GT_ROOT"} {
-		if townRoot := os.Getenv(envName); townRoot != "" {
-			// Verify it's actually a workspace
-			if ok, _ := IsWorkspace(townRoot); ok {
-				return townRoot, nil
-			}
+	// Fallback: try the town root env var (set by shell integration or session manager)
+	if townRoot, _, _ := config.ResolveRootEnv(); townRoot != "" {
+		// Verify it's actually a workspace
+		if ok, _ := IsWorkspace(townRoot); ok {
+			return townRoot, nil
 		}
 	}
 
@@ -113,14 +128,14 @@ func FindFromCwdOrError() (string, error) {
 }
 
 // FindFromCwdWithFallback is like FindFromCwdOrError but returns (townRoot, cwd, error).
-// If getcwd fails, returns (townRoot, "", nil) using GT_TOWN_ROOT fallback.
+// If getcwd fails, returns (townRoot, "", nil) using the GT_ROOT env fallback.
 // This is useful for commands like `gt done` that need to continue even if the
 // working directory is deleted (e.g., polecat worktree nuked by Witness).
 func FindFromCwdWithFallback() (townRoot string, cwd string, err error) {
 	cwd, err = os.Getwd()
 	if err != nil {
-		// Fallback: try GT_TOWN_ROOT env var
-		if townRoot = os.Getenv("GT_TOWN_ROOT"); townRoot != "" {
+		// Fallback: try the town root env var
+		if townRoot, _, _ = config.ResolveRootEnv(); townRoot != "" {
 			// Verify it's actually a workspace
 			if _, statErr := os.Stat(filepath.Join(townRoot, PrimaryMarker)); statErr == nil {
 				return townRoot, "", nil // cwd is gone but townRoot is valid
@@ -165,8 +180,7 @@ func IsWorkspace(dir string) (bool, error) {
 // This is used for generating unique tmux session names that avoid collisions
 // when running multiple Gas Town instances.
 func GetTownName(townRoot string) (string, error) {
-	townConfigPath := filepath.Join(townRoot, PrimaryMarker)
-	townConfig, err := config.LoadTownConfig(townConfigPath)
+	townConfig, err := config.ReadTownJSON(townRoot)
 	if err != nil {
 		return "", fmt.Errorf("loading town config: %w", err)
 	}
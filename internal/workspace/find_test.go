@@ -278,3 +278,56 @@ func TestFindSkipsNestedWorkspaceInCrew(t *testing.T) {
 		t.Errorf("Find = %q, want %q (should skip nested workspace in crew/)", found, root)
 	}
 }
+
+func TestFindThroughSymlinkOutsideTown(t *testing.T) {
+	// A directory outside the town that links into it (e.g. a unified
+	// memory dir) has no town among its lexical parents.
+	root := realPath(t, t.TempDir())
+	if err := os.MkdirAll(filepath.Join(root, "mayor"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "mayor", "town.json"), []byte(`{}`), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	target := filepath.Join(root, "gastown", "polecats", "worker")
+	if err := os.MkdirAll(target, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	link := filepath.Join(t.TempDir(), "memory")
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("symlink not supported: %v", err)
+	}
+
+	found, err := Find(link)
+	if err != nil {
+		t.Fatalf("Find: %v", err)
+	}
+	if found != root {
+		t.Errorf("Find = %q, want %q", found, root)
+	}
+}
+
+func TestFindFromCwdOrError_SymlinkedRootEnv(t *testing.T) {
+	root := realPath(t, t.TempDir())
+	if err := os.MkdirAll(filepath.Join(root, "mayor"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "mayor", "town.json"), []byte(`{}`), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	link := filepath.Join(t.TempDir(), "town-link")
+	if err := os.Symlink(root, link); err != nil {
+		t.Skipf("symlink not supported: %v", err)
+	}
+
+	t.Chdir(t.TempDir())
+	t.Setenv("GT_ROOT", link)
+	found, err := FindFromCwdOrError()
+	if err != nil {
+		t.Fatalf("FindFromCwdOrError: %v", err)
+	}
+	if found != link {
+		t.Errorf("FindFromCwdOrError = %q, want %q", found, link)
+	}
+}
@@ -7,7 +7,7 @@
 
 set -euo pipefail
 
-TOWN_ROOT="${GT_TOWN_ROOT:-$(gt town root 2>/dev/null)}"
+TOWN_ROOT="${GT_ROOT:-${GT_TOWN_ROOT:-$(gt town root 2>/dev/null)}}"
 RIG_ROOT="${TOWN_ROOT}/gastown/mayor/rig"
 
 log() { echo "[rebuild-gt] $*"; }